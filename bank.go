@@ -0,0 +1,354 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	strIBANErrorCode        = "IBAN"
+	strBICErrorCode         = "BIC"
+	strBankAccountErrorCode = "BANK_ACCOUNT"
+)
+
+const (
+	strIBANErrorMessage        = "%v is not an IBAN"
+	strBICErrorMessage         = "%v is not a BIC"
+	strBankAccountErrorMessage = "%v is not a bank account number of %v"
+)
+
+// Components of bank details reported in the Component arg.
+const (
+	bankComponentCountry  = "country"
+	bankComponentLength   = "length"
+	bankComponentBBAN     = "bban"
+	bankComponentChecksum = "checksum"
+	bankComponentBank     = "bank"
+	bankComponentLocation = "location"
+	bankComponentBranch   = "branch"
+	bankComponentAccount  = "account"
+)
+
+// bankIBANFormats maps country codes to the length of IBANs and the structure
+// of BBANs, in the notation of the SWIFT IBAN registry, where n is a digit, a
+// is an uppercase letter and c is an alphanumeric character.
+var bankIBANFormats = map[string]struct {
+	length int
+	bban   string
+}{
+	"AD": {24, "4!n4!n12!c"},
+	"AE": {23, "3!n16!n"},
+	"AL": {28, "8!n16!c"},
+	"AT": {20, "5!n11!n"},
+	"AZ": {28, "4!a20!c"},
+	"BA": {20, "3!n3!n8!n2!n"},
+	"BE": {16, "3!n7!n2!n"},
+	"BG": {22, "4!a4!n2!n8!c"},
+	"BH": {22, "4!a14!c"},
+	"BR": {29, "8!n5!n10!n1!a1!c"},
+	"CH": {21, "5!n12!c"},
+	"CR": {22, "4!n14!n"},
+	"CY": {28, "3!n5!n16!c"},
+	"CZ": {24, "4!n6!n10!n"},
+	"DE": {22, "8!n10!n"},
+	"DK": {18, "4!n9!n1!n"},
+	"DO": {28, "4!c20!n"},
+	"EE": {20, "2!n2!n11!n1!n"},
+	"EG": {29, "4!n4!n17!n"},
+	"ES": {24, "4!n4!n1!n1!n10!n"},
+	"FI": {18, "3!n11!n"},
+	"FO": {18, "4!n9!n1!n"},
+	"FR": {27, "5!n5!n11!c2!n"},
+	"GB": {22, "4!a6!n8!n"},
+	"GE": {22, "2!a16!n"},
+	"GI": {23, "4!a15!c"},
+	"GL": {18, "4!n9!n1!n"},
+	"GR": {27, "3!n4!n16!c"},
+	"GT": {28, "4!c20!c"},
+	"HR": {21, "7!n10!n"},
+	"HU": {28, "3!n4!n1!n15!n1!n"},
+	"IE": {22, "4!a6!n8!n"},
+	"IL": {23, "3!n3!n13!n"},
+	"IQ": {23, "4!a3!n12!n"},
+	"IS": {26, "4!n2!n6!n10!n"},
+	"IT": {27, "1!a5!n5!n12!c"},
+	"JO": {30, "4!a4!n18!c"},
+	"KW": {30, "4!a22!c"},
+	"KZ": {20, "3!n13!c"},
+	"LB": {28, "4!n20!c"},
+	"LC": {32, "4!a24!c"},
+	"LI": {21, "5!n12!c"},
+	"LT": {20, "5!n11!n"},
+	"LU": {20, "3!n13!c"},
+	"LV": {21, "4!a13!c"},
+	"MC": {27, "5!n5!n11!c2!n"},
+	"MD": {24, "2!c18!c"},
+	"ME": {22, "3!n13!n2!n"},
+	"MK": {19, "3!n10!c2!n"},
+	"MR": {27, "5!n5!n11!n2!n"},
+	"MT": {31, "4!a5!n18!c"},
+	"MU": {30, "4!a2!n2!n12!n3!n3!a"},
+	"NL": {18, "4!a10!n"},
+	"NO": {15, "4!n6!n1!n"},
+	"PK": {24, "4!a16!c"},
+	"PL": {28, "8!n16!n"},
+	"PS": {29, "4!a21!c"},
+	"PT": {25, "4!n4!n11!n2!n"},
+	"QA": {29, "4!a21!c"},
+	"RO": {24, "4!a16!c"},
+	"RS": {22, "3!n13!n2!n"},
+	"SA": {24, "2!n18!c"},
+	"SC": {31, "4!a2!n2!n16!n3!a"},
+	"SE": {24, "3!n16!n1!n"},
+	"SI": {19, "5!n8!n2!n"},
+	"SK": {24, "4!n6!n10!n"},
+	"SM": {27, "1!a5!n5!n12!c"},
+	"ST": {25, "4!n4!n11!n2!n"},
+	"SV": {28, "4!a20!n"},
+	"TL": {23, "3!n14!n2!n"},
+	"TN": {24, "2!n3!n13!n2!n"},
+	"TR": {26, "5!n1!n16!c"},
+	"UA": {29, "6!n19!c"},
+	"VA": {22, "3!n15!n"},
+	"VG": {24, "4!a16!n"},
+	"XK": {20, "4!n10!n2!n"},
+}
+
+// BankAccountRule checks a domestic bank account number, with spaces and
+// hyphens removed. It returns the failing component, eg. "branch" or
+// "account", or "" if account is valid.
+type BankAccountRule func(account string) string
+
+var (
+	bankAccountRulesMu sync.RWMutex
+	bankAccountRules   = map[string]BankAccountRule{
+		"GB": bankAccountGB,
+		"US": bankAccountUS,
+	}
+)
+
+// RegisterBankAccountRule sets the rule used by StringBankAccount to check bank
+// account numbers of country, an ISO 3166-1 alpha-2 code, replacing any
+// existing rule. RegisterBankAccountRule is safe for concurrent use.
+func RegisterBankAccountRule(country string, rule BankAccountRule) {
+	bankAccountRulesMu.Lock()
+	defer bankAccountRulesMu.Unlock()
+
+	bankAccountRules[strings.ToUpper(country)] = rule
+}
+
+// StringIBAN returns error if value is not an IBAN, otherwise nil. Spaces are
+// ignored and letters are matched case-insensitively. The country code, length,
+// BBAN structure and mod-97 checksum are checked.
+func StringIBAN(field, value string) *ErrValidation {
+	s := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+
+	args := struct {
+		Country   string
+		Component string
+	}{
+		"", "",
+	}
+	code := fmt.Sprintf(strErrorCode, strIBANErrorCode)
+	message := fmt.Sprintf(strIBANErrorMessage, field)
+
+	if len(s) < 4 {
+		args.Component = bankComponentLength
+
+		return NewError(code, args, message, field, value)
+	}
+
+	args.Country = s[:2]
+	format, ok := bankIBANFormats[s[:2]]
+
+	if !ok {
+		args.Component = bankComponentCountry
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if len(s) != format.length {
+		args.Component = bankComponentLength
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if !strDigits(s[2:4]) || !bankStructure(s[4:], format.bban) {
+		args.Component = bankComponentBBAN
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if !bankMod97(s[4:] + s[:4]) {
+		args.Component = bankComponentChecksum
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringBIC returns error if value is not a BIC, also known as a SWIFT code,
+// otherwise nil. A BIC is a 4-letter bank code, a 2-letter country code, a
+// 2-character location code and an optional 3-character branch code.
+func StringBIC(field, value string) *ErrValidation {
+	args := struct {
+		Country   string
+		Component string
+	}{
+		"", "",
+	}
+	code := fmt.Sprintf(strErrorCode, strBICErrorCode)
+	message := fmt.Sprintf(strBICErrorMessage, field)
+
+	switch {
+	case len(value) != 8 && len(value) != 11:
+		args.Component = bankComponentLength
+	case !bankStructure(value[:4], "4!a"):
+		args.Component = bankComponentBank
+	case !bankStructure(value[4:6], "2!a"):
+		args.Component = bankComponentCountry
+	case !bankStructure(value[6:8], "2!c"):
+		args.Country = value[4:6]
+		args.Component = bankComponentLocation
+	case len(value) == 11 && !bankStructure(value[8:], "3!c"):
+		args.Country = value[4:6]
+		args.Component = bankComponentBranch
+	default:
+		return nil
+	}
+
+	return NewError(code, args, message, field, value)
+}
+
+// StringBankAccount returns error if value is not a domestic bank account
+// number of country, an ISO 3166-1 alpha-2 code, otherwise nil. Spaces and
+// hyphens are ignored. The rule registered with RegisterBankAccountRule is
+// used, or for countries using IBANs without a registered rule, the BBAN
+// structure. Countries without either are rejected.
+func StringBankAccount(field, value, country string) *ErrValidation {
+	country = strings.ToUpper(country)
+	s := strings.NewReplacer(" ", "", "-", "").Replace(value)
+
+	bankAccountRulesMu.RLock()
+	rule, ok := bankAccountRules[country]
+	bankAccountRulesMu.RUnlock()
+
+	args := struct {
+		Country   string
+		Component string
+	}{
+		country, "",
+	}
+
+	if ok {
+		args.Component = rule(s)
+	} else if format, ok := bankIBANFormats[country]; ok {
+		if !bankStructure(s, format.bban) {
+			args.Component = bankComponentBBAN
+		}
+	} else {
+		args.Component = bankComponentCountry
+	}
+
+	if args.Component != "" {
+		code := fmt.Sprintf(strErrorCode, strBankAccountErrorCode)
+		message := fmt.Sprintf(strBankAccountErrorMessage, field, country)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// bankAccountGB checks a UK sort code followed by an 8-digit account number.
+func bankAccountGB(account string) string {
+	if len(account) != 14 || !strDigits(account[:6]) {
+		return bankComponentBranch
+	}
+
+	if !strDigits(account[6:]) {
+		return bankComponentAccount
+	}
+
+	return ""
+}
+
+// bankAccountUS checks an ABA routing number followed by an account number of
+// 4 to 17 digits.
+func bankAccountUS(account string) string {
+	if len(account) < 9 || !strDigits(account[:9]) {
+		return bankComponentBank
+	}
+
+	sum := 0
+
+	for i, w := range []int{3, 7, 1, 3, 7, 1, 3, 7, 1} {
+		sum += int(account[i]-'0') * w
+	}
+
+	if sum%10 != 0 {
+		return bankComponentBank
+	}
+
+	if len(account) < 13 || len(account) > 26 || !strDigits(account[9:]) {
+		return bankComponentAccount
+	}
+
+	return ""
+}
+
+// bankStructure reports whether s matches structure, in the notation of the
+// SWIFT IBAN registry, eg. 4!a6!n8!n.
+func bankStructure(s, structure string) bool {
+	for structure != "" {
+		j := strings.IndexByte(structure, '!')
+		n, _ := strconv.Atoi(structure[:j])
+		class := structure[j+1]
+		structure = structure[j+2:]
+
+		if len(s) < n {
+			return false
+		}
+
+		for k := 0; k < n; k++ {
+			c := s[k]
+			digit := c >= '0' && c <= '9'
+			upper := c >= 'A' && c <= 'Z'
+
+			switch {
+			case class == 'n' && !digit,
+				class == 'a' && !upper,
+				class == 'c' && !digit && !upper && (c < 'a' || c > 'z'):
+				return false
+			}
+		}
+
+		s = s[n:]
+	}
+
+	return s == ""
+}
+
+// bankMod97 reports whether s, with letters converted to numbers as A=10 to
+// Z=35, is congruent to 1 mod 97.
+func bankMod97(s string) bool {
+	r := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c >= '0' && c <= '9':
+			r = (r*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			r = (r*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return r == 1
+}
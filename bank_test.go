@@ -0,0 +1,121 @@
+package validation
+
+import "testing"
+
+// bankComponent returns the Component arg of err, or "" if err is nil.
+func bankComponent(err *ErrValidation) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Args.(struct {
+		Country   string
+		Component string
+	}).Component
+}
+
+func TestStringIBAN(t *testing.T) {
+	tests := []struct {
+		value     string
+		component string
+	}{
+		// Examples of the SWIFT IBAN registry and national banks.
+		{"GB82WEST12345698765432", ""},
+		{"GB82 WEST 1234 5698 7654 32", ""},
+		{"gb82west12345698765432", ""},
+		{"DE89370400440532013000", ""},
+		{"FR1420041010050500013M02606", ""},
+		{"NL91ABNA0417164300", ""},
+		{"BE68539007547034", ""},
+		{"CH9300762011623852957", ""},
+		{"ES9121000418450200051332", ""},
+		{"IT60X0542811101000000123456", ""},
+		{"NO9386011117947", ""},
+		{"MT84MALT011000012345MTLCAST001S", ""},
+		{"GB82WEST12345698765433", "checksum"},
+		{"DE89370400440532013001", "checksum"},
+		{"GB28WEST12345698765432", "checksum"},
+		{"GB82WEST1234569876543", "length"},
+		{"GB82", "length"},
+		{"GB", "length"},
+		{"XX82WEST12345698765432", "country"},
+		{"GB82WEST1234569876543A", "bban"},
+		{"GBXXWEST12345698765432", "bban"},
+	}
+
+	for _, tt := range tests {
+		if component := bankComponent(StringIBAN("f", tt.value)); component != tt.component {
+			t.Errorf("StringIBAN(%q) component = %q, want %q", tt.value, component, tt.component)
+		}
+	}
+}
+
+func TestStringBIC(t *testing.T) {
+	tests := []struct {
+		value     string
+		component string
+	}{
+		{"DEUTDEFF", ""},
+		{"DEUTDEFF500", ""},
+		{"NEDSZAJJXXX", ""},
+		{"BOFAUS3N", ""},
+		{"DEUTDEF", "length"},
+		{"DEUTDEFF5000", "length"},
+		{"DEU1DEFF", "bank"},
+		{"DEUT12FF", "country"},
+		{"DEUTDEF!", "location"},
+		{"DEUTDEFF50!", "branch"},
+	}
+
+	for _, tt := range tests {
+		if component := bankComponent(StringBIC("f", tt.value)); component != tt.component {
+			t.Errorf("StringBIC(%q) component = %q, want %q", tt.value, component, tt.component)
+		}
+	}
+}
+
+func TestStringBankAccount(t *testing.T) {
+	tests := []struct {
+		value, country string
+		component      string
+	}{
+		{"60-16-13 31926819", "GB", ""},
+		{"601613 3192681", "GB", "branch"},
+		{"60161A31926819", "GB", "branch"},
+		{"6016133192681A", "GB", "account"},
+		// ABA routing numbers of JPMorgan Chase and Bank of America.
+		{"021000021 1234567890", "US", ""},
+		{"026009593-0000", "us", ""},
+		{"021000022 1234567890", "US", "bank"},
+		{"02100002", "US", "bank"},
+		{"021000021 123", "US", "account"},
+		{"021000021 123456789012345678", "US", "account"},
+		{"370400440532013000", "DE", ""},
+		{"37040044053201300", "DE", "bban"},
+		{"12345678", "ZZ", "country"},
+	}
+
+	for _, tt := range tests {
+		if component := bankComponent(StringBankAccount("f", tt.value, tt.country)); component != tt.component {
+			t.Errorf("StringBankAccount(%q, %q) component = %q, want %q", tt.value, tt.country, component, tt.component)
+		}
+	}
+}
+
+func TestRegisterBankAccountRule(t *testing.T) {
+	RegisterBankAccountRule("qz", func(account string) string {
+		if account != "12345" {
+			return "account"
+		}
+
+		return ""
+	})
+
+	if err := StringBankAccount("f", "123-45", "QZ"); err != nil {
+		t.Errorf("StringBankAccount with registered rule = %v, want nil", err)
+	}
+
+	if component := bankComponent(StringBankAccount("f", "12346", "QZ")); component != "account" {
+		t.Errorf("StringBankAccount with registered rule component = %q, want %q", component, "account")
+	}
+}
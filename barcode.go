@@ -0,0 +1,282 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	strISBNErrorCode           = "ISBN"
+	strISBNCheckDigitErrorCode = "ISBN_CHECK_DIGIT"
+	strISSNErrorCode           = "ISSN"
+	strISSNCheckDigitErrorCode = "ISSN_CHECK_DIGIT"
+	strEANErrorCode            = "EAN"
+	strEANCheckDigitErrorCode  = "EAN_CHECK_DIGIT"
+	strUPCErrorCode            = "UPC"
+	strUPCCheckDigitErrorCode  = "UPC_CHECK_DIGIT"
+	strGTINErrorCode           = "GTIN"
+	strGTINCheckDigitErrorCode = "GTIN_CHECK_DIGIT"
+)
+
+const (
+	strISBNErrorMessage = "%v is not an ISBN"
+	strISSNErrorMessage = "%v is not an ISSN"
+	strEANErrorMessage  = "%v is not an EAN-%v"
+	strUPCErrorMessage  = "%v is not a UPC-%v"
+	strGTINErrorMessage = "%v is not a GTIN"
+
+	strCheckDigitErrorMessage = "%v has an invalid check digit"
+)
+
+// StringISBN returns error if value is neither an ISBN-10 nor an ISBN-13,
+// otherwise nil. If separators is true, hyphens and spaces are ignored.
+func StringISBN(field, value string, separators bool) *ErrValidation {
+	_, err := ISBN13(field, value, separators)
+
+	return err
+}
+
+// StringISBN10 returns error if value is not an ISBN-10, otherwise nil. The
+// check digit may be X. If separators is true, hyphens and spaces are ignored.
+func StringISBN10(field, value string, separators bool) *ErrValidation {
+	s := barcodeStrip(value, separators)
+
+	if len(s) != 10 || !strDigits(s[:9]) || !strDigits(s[9:]) && s[9] != 'X' && s[9] != 'x' {
+		return barcodeError(field, value, strISBNErrorCode, fmt.Sprintf(strISBNErrorMessage, field))
+	}
+
+	return barcodeCheckDigit(field, value, strISBNCheckDigitErrorCode, barcodeISBN10Check(s[:9]), strings.ToUpper(s[9:]))
+}
+
+// StringISBN13 returns error if value is not an ISBN-13, ie. an EAN-13
+// starting with 978 or 979, otherwise nil. If separators is true, hyphens and
+// spaces are ignored.
+func StringISBN13(field, value string, separators bool) *ErrValidation {
+	s := barcodeStrip(value, separators)
+
+	if len(s) != 13 || !strDigits(s) || !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+		return barcodeError(field, value, strISBNErrorCode, fmt.Sprintf(strISBNErrorMessage, field))
+	}
+
+	return barcodeCheckDigit(field, value, strISBNCheckDigitErrorCode, barcodeGS1Check(s[:12]), s[12:])
+}
+
+// ISBN13 returns value as an ISBN-13 without separators, converting it from an
+// ISBN-10 if needed, or error if value is not an ISBN. If separators is true,
+// hyphens and spaces are ignored.
+func ISBN13(field, value string, separators bool) (string, *ErrValidation) {
+	s := barcodeStrip(value, separators)
+
+	if len(s) == 10 {
+		if err := StringISBN10(field, value, separators); err != nil {
+			return "", err
+		}
+
+		s = "978" + s[:9]
+
+		return s + barcodeGS1Check(s), nil
+	}
+
+	if err := StringISBN13(field, value, separators); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+// ISBN10 returns value as an ISBN-10 without separators, converting it from an
+// ISBN-13 if needed, or error if value is not an ISBN or is an ISBN-13 not
+// starting with 978, which has no ISBN-10 form. If separators is true, hyphens
+// and spaces are ignored.
+func ISBN10(field, value string, separators bool) (string, *ErrValidation) {
+	s, err := ISBN13(field, value, separators)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(s, "978") {
+		return "", barcodeError(field, value, strISBNErrorCode, fmt.Sprintf(strISBNErrorMessage, field))
+	}
+
+	return s[3:12] + barcodeISBN10Check(s[3:12]), nil
+}
+
+// StringISSN returns error if value is not an ISSN, eg. 0317-8471, otherwise
+// nil. The check digit may be X. If separators is true, hyphens and spaces are
+// ignored, otherwise the hyphen is required.
+func StringISSN(field, value string, separators bool) *ErrValidation {
+	s := value
+
+	if separators {
+		s = barcodeStrip(value, separators)
+	} else if len(s) == 9 && s[4] == '-' {
+		s = s[:4] + s[5:]
+	} else {
+		s = ""
+	}
+
+	if len(s) != 8 || !strDigits(s[:7]) || !strDigits(s[7:]) && s[7] != 'X' && s[7] != 'x' {
+		return barcodeError(field, value, strISSNErrorCode, fmt.Sprintf(strISSNErrorMessage, field))
+	}
+
+	sum := 0
+
+	for i := 0; i < 7; i++ {
+		sum += int(s[i]-'0') * (8 - i)
+	}
+
+	return barcodeCheckDigit(field, value, strISSNCheckDigitErrorCode, barcodeMod11Digit(sum), strings.ToUpper(s[7:]))
+}
+
+// StringEAN8 returns error if value is not an EAN-8, otherwise nil. If
+// separators is true, hyphens and spaces are ignored.
+func StringEAN8(field, value string, separators bool) *ErrValidation {
+	return barcodeGS1(field, value, separators, 8, strEANErrorCode, strEANCheckDigitErrorCode, fmt.Sprintf(strEANErrorMessage, field, 8))
+}
+
+// StringEAN13 returns error if value is not an EAN-13, otherwise nil. If
+// separators is true, hyphens and spaces are ignored.
+func StringEAN13(field, value string, separators bool) *ErrValidation {
+	return barcodeGS1(field, value, separators, 13, strEANErrorCode, strEANCheckDigitErrorCode, fmt.Sprintf(strEANErrorMessage, field, 13))
+}
+
+// StringUPCA returns error if value is not a UPC-A, otherwise nil. If
+// separators is true, hyphens and spaces are ignored.
+func StringUPCA(field, value string, separators bool) *ErrValidation {
+	return barcodeGS1(field, value, separators, 12, strUPCErrorCode, strUPCCheckDigitErrorCode, fmt.Sprintf(strUPCErrorMessage, field, "A"))
+}
+
+// StringUPCE returns error if value is not an 8-digit UPC-E, with number
+// system 0 or 1, otherwise nil. The check digit is that of the UPC-A the UPC-E
+// expands to. If separators is true, hyphens and spaces are ignored.
+func StringUPCE(field, value string, separators bool) *ErrValidation {
+	s := barcodeStrip(value, separators)
+
+	if len(s) != 8 || !strDigits(s) || s[0] != '0' && s[0] != '1' {
+		return barcodeError(field, value, strUPCErrorCode, fmt.Sprintf(strUPCErrorMessage, field, "E"))
+	}
+
+	return barcodeCheckDigit(field, value, strUPCCheckDigitErrorCode, barcodeGS1Check(barcodeUPCEExpand(s[:7])), s[7:])
+}
+
+// StringGTIN returns error if value is not a GTIN-8, GTIN-12, GTIN-13 or
+// GTIN-14, otherwise nil. If separators is true, hyphens and spaces are
+// ignored.
+func StringGTIN(field, value string, separators bool) *ErrValidation {
+	length := len(barcodeStrip(value, separators))
+
+	if length != 8 && length != 12 && length != 13 {
+		length = 14
+	}
+
+	return barcodeGS1(field, value, separators, length, strGTINErrorCode, strGTINCheckDigitErrorCode, fmt.Sprintf(strGTINErrorMessage, field))
+}
+
+// StringGTIN14 returns error if value is not a GTIN-14, otherwise nil. If
+// separators is true, hyphens and spaces are ignored.
+func StringGTIN14(field, value string, separators bool) *ErrValidation {
+	return barcodeGS1(field, value, separators, 14, strGTINErrorCode, strGTINCheckDigitErrorCode, fmt.Sprintf(strGTINErrorMessage, field))
+}
+
+// barcodeGS1 returns error if value is not a GS1 number of length digits with
+// a valid check digit.
+func barcodeGS1(field, value string, separators bool, length int, code, checkDigitCode, message string) *ErrValidation {
+	s := barcodeStrip(value, separators)
+
+	if len(s) != length || !strDigits(s) {
+		return barcodeError(field, value, code, message)
+	}
+
+	return barcodeCheckDigit(field, value, checkDigitCode, barcodeGS1Check(s[:length-1]), s[length-1:])
+}
+
+// barcodeError returns error with code and message, without args.
+func barcodeError(field, value, code, message string) *ErrValidation {
+	return NewError(fmt.Sprintf(strErrorCode, code), struct{}{}, message, field, value)
+}
+
+// barcodeCheckDigit returns error with code if expected!=actual, otherwise
+// nil.
+func barcodeCheckDigit(field, value, code, expected, actual string) *ErrValidation {
+	if expected != actual {
+		args := struct {
+			Expected, Actual string
+		}{
+			expected, actual,
+		}
+		code = fmt.Sprintf(strErrorCode, code)
+		message := fmt.Sprintf(strCheckDigitErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// barcodeStrip removes hyphens and spaces from value if separators is true.
+func barcodeStrip(value string, separators bool) string {
+	if !separators {
+		return value
+	}
+
+	return strings.NewReplacer("-", "", " ", "").Replace(value)
+}
+
+// barcodeGS1Check returns the GS1 mod 10 check digit of digits.
+func barcodeGS1Check(digits string) string {
+	sum := 0
+
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+
+		if i%2 == 0 {
+			d *= 3
+		}
+
+		sum += d
+	}
+
+	return fmt.Sprint((10 - sum%10) % 10)
+}
+
+// barcodeISBN10Check returns the check digit of the first 9 digits of an
+// ISBN-10.
+func barcodeISBN10Check(digits string) string {
+	sum := 0
+
+	for i := 0; i < 9; i++ {
+		sum += int(digits[i]-'0') * (10 - i)
+	}
+
+	return barcodeMod11Digit(sum)
+}
+
+// barcodeMod11Digit returns the mod 11 check digit of the weighted sum, with X
+// for 10.
+func barcodeMod11Digit(sum int) string {
+	c := (11 - sum%11) % 11
+
+	if c == 10 {
+		return "X"
+	}
+
+	return fmt.Sprint(c)
+}
+
+// barcodeUPCEExpand expands the first 7 digits of a UPC-E, ie. the number
+// system and the 6 data digits, to the first 11 digits of a UPC-A.
+func barcodeUPCEExpand(s string) string {
+	ns, d := s[:1], s[1:]
+
+	switch d[5] {
+	case '0', '1', '2':
+		return ns + d[:2] + d[5:] + "0000" + d[2:5]
+	case '3':
+		return ns + d[:3] + "00000" + d[3:5]
+	case '4':
+		return ns + d[:4] + "00000" + d[4:5]
+	default:
+		return ns + d[:5] + "0000" + d[5:]
+	}
+}
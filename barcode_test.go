@@ -0,0 +1,140 @@
+package validation
+
+import "testing"
+
+func TestStringISBN(t *testing.T) {
+	tests := []struct {
+		value      string
+		separators bool
+		code       string
+	}{
+		// Examples of the ISBN Users' Manual and Wikipedia.
+		{"978-0-306-40615-7", true, ""},
+		{"9780306406157", false, ""},
+		{"0-306-40615-2", true, ""},
+		{"0-8044-2957-X", true, ""},
+		{"080442957x", false, ""},
+		{"979-10-90636-07-1", true, ""},
+		{"978-0-306-40615-7", false, "ERROR_STRING_ISBN"},
+		{"9780306406158", false, "ERROR_STRING_ISBN_CHECK_DIGIT"},
+		{"0306406153", false, "ERROR_STRING_ISBN_CHECK_DIGIT"},
+		{"0804429579", false, "ERROR_STRING_ISBN_CHECK_DIGIT"},
+		{"9770306406157", false, "ERROR_STRING_ISBN"},
+		{"030640615X0", false, "ERROR_STRING_ISBN"},
+		{"X306406152", false, "ERROR_STRING_ISBN"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringISBN("f", tt.value, tt.separators)); code != tt.code {
+			t.Errorf("StringISBN(%q, %v) = %q, want %q", tt.value, tt.separators, code, tt.code)
+		}
+	}
+}
+
+func TestISBNConversion(t *testing.T) {
+	tests := []struct {
+		value, isbn13, isbn10 string
+	}{
+		{"0-306-40615-2", "9780306406157", "0306406152"},
+		{"978-0-8044-2957-3", "9780804429573", "080442957X"},
+		{"979-10-90636-07-1", "9791090636071", ""},
+	}
+
+	for _, tt := range tests {
+		if s, err := ISBN13("f", tt.value, true); s != tt.isbn13 || err != nil {
+			t.Errorf("ISBN13(%q) = %q, %v, want %q", tt.value, s, err, tt.isbn13)
+		}
+
+		if s, err := ISBN10("f", tt.value, true); s != tt.isbn10 || (err == nil) != (tt.isbn10 != "") {
+			t.Errorf("ISBN10(%q) = %q, %v, want %q", tt.value, s, err, tt.isbn10)
+		}
+	}
+}
+
+func TestStringISSN(t *testing.T) {
+	tests := []struct {
+		value      string
+		separators bool
+		code       string
+	}{
+		{"0317-8471", false, ""},
+		{"0378-5955", false, ""},
+		{"2434-561X", false, ""},
+		{"2434-561x", false, ""},
+		{"2434 561X", true, ""},
+		{"03178471", false, "ERROR_STRING_ISSN"},
+		{"03178471", true, ""},
+		{"0317-8472", false, "ERROR_STRING_ISSN_CHECK_DIGIT"},
+		{"2434-5610", false, "ERROR_STRING_ISSN_CHECK_DIGIT"},
+		{"X317-8471", false, "ERROR_STRING_ISSN"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringISSN("f", tt.value, tt.separators)); code != tt.code {
+			t.Errorf("StringISSN(%q, %v) = %q, want %q", tt.value, tt.separators, code, tt.code)
+		}
+	}
+}
+
+func TestStringGS1(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(field, value string, separators bool) *ErrValidation
+		value string
+		code  string
+	}{
+		// Examples of GS1 and Wikipedia.
+		{"EAN8", StringEAN8, "73513537", ""},
+		{"EAN8", StringEAN8, "96385074", ""},
+		{"EAN8", StringEAN8, "73513536", "ERROR_STRING_EAN_CHECK_DIGIT"},
+		{"EAN8", StringEAN8, "7351353", "ERROR_STRING_EAN"},
+		{"EAN13", StringEAN13, "4006381333931", ""},
+		{"EAN13", StringEAN13, "5901234123457", ""},
+		{"EAN13", StringEAN13, "4006381333932", "ERROR_STRING_EAN_CHECK_DIGIT"},
+		{"EAN13", StringEAN13, "400638133393A", "ERROR_STRING_EAN"},
+		{"UPCA", StringUPCA, "036000291452", ""},
+		{"UPCA", StringUPCA, "012345678905", ""},
+		{"UPCA", StringUPCA, "036000291453", "ERROR_STRING_UPC_CHECK_DIGIT"},
+		{"UPCE", StringUPCE, "04252614", ""},
+		{"UPCE", StringUPCE, "01234565", ""},
+		{"UPCE", StringUPCE, "04252615", "ERROR_STRING_UPC_CHECK_DIGIT"},
+		{"UPCE", StringUPCE, "24252614", "ERROR_STRING_UPC"},
+		{"GTIN", StringGTIN, "73513537", ""},
+		{"GTIN", StringGTIN, "036000291452", ""},
+		{"GTIN", StringGTIN, "4006381333931", ""},
+		{"GTIN", StringGTIN, "10012345678902", ""},
+		{"GTIN", StringGTIN, "10012345678903", "ERROR_STRING_GTIN_CHECK_DIGIT"},
+		{"GTIN", StringGTIN, "1001234567890", "ERROR_STRING_GTIN_CHECK_DIGIT"},
+		{"GTIN", StringGTIN, "123456789", "ERROR_STRING_GTIN"},
+		{"GTIN14", StringGTIN14, "00012345678905", ""},
+		{"GTIN14", StringGTIN14, "012345678905", "ERROR_STRING_GTIN"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.fn("f", tt.value, false)); code != tt.code {
+			t.Errorf("String%v(%q) = %q, want %q", tt.name, tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestBarcodeUPCEExpand(t *testing.T) {
+	// The expansion rules of UPC-E, by the last data digit.
+	tests := []struct {
+		upce, upca string
+	}{
+		{"0425261", "04210000526"},
+		{"0123450", "01200000345"},
+		{"0123451", "01210000345"},
+		{"0123452", "01220000345"},
+		{"0123453", "01230000045"},
+		{"0123454", "01234000005"},
+		{"0123455", "01234500005"},
+		{"1123459", "11234500009"},
+	}
+
+	for _, tt := range tests {
+		if upca := barcodeUPCEExpand(tt.upce); upca != tt.upca {
+			t.Errorf("barcodeUPCEExpand(%q) = %q, want %q", tt.upce, upca, tt.upca)
+		}
+	}
+}
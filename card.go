@@ -0,0 +1,199 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	strCardNumberErrorCode = "CARD_NUMBER"
+	strCardLuhnErrorCode   = "CARD_LUHN"
+	strCardBrandErrorCode  = "CARD_BRAND"
+	strCardLengthErrorCode = "CARD_LENGTH"
+)
+
+const (
+	strCardNumberErrorMessage = "%v is not a card number"
+	strCardLuhnErrorMessage   = "%v has an invalid check digit"
+	strCardBrandErrorMessage  = "%v is not a card of %v"
+	strCardLengthErrorMessage = "%v has an invalid length for %v"
+)
+
+// cardBrands maps IIN ranges to card brands. Ranges are checked in order, so
+// narrower ranges must come before the wider ranges that contain them.
+var cardBrands = []struct {
+	brand   string
+	ranges  [][2]int
+	lengths []int
+}{
+	{"amex", [][2]int{{34, 34}, {37, 37}}, []int{15}},
+	{"diners", [][2]int{{300, 305}, {36, 36}, {38, 39}}, []int{14, 15, 16, 17, 18, 19}},
+	{"jcb", [][2]int{{3528, 3589}}, []int{16, 17, 18, 19}},
+	{"visa", [][2]int{{4, 4}}, []int{13, 16, 19}},
+	{"mastercard", [][2]int{{51, 55}, {2221, 2720}}, []int{16}},
+	{"discover", [][2]int{{6011, 6011}, {644, 649}, {65, 65}}, []int{16, 17, 18, 19}},
+	{"unionpay", [][2]int{{62, 62}}, []int{16, 17, 18, 19}},
+	{"maestro", [][2]int{{50, 50}, {56, 58}, {6304, 6304}, {67, 67}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// StringCardNumber returns error if value is not a payment card number,
+// otherwise nil. Spaces and hyphens are ignored. The number must pass the Luhn
+// check, and its length must be valid for the brand detected from its IIN.
+// If brands is not empty, the detected brand must be one of brands. Brands are
+// amex, diners, discover, jcb, maestro, mastercard, unionpay and visa.
+//
+// The card number is masked in Value, so only the first 6 and last 4 digits
+// are kept, and is never included in the message.
+func StringCardNumber(field, value string, brands []string) *ErrValidation {
+	masked := cardMask(value)
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+
+	args := struct {
+		Brand string
+	}{
+		"",
+	}
+
+	if digits == "" || len(digits) > 19 {
+		code := fmt.Sprintf(strErrorCode, strCardNumberErrorCode)
+		message := fmt.Sprintf(strCardNumberErrorMessage, field)
+
+		return NewError(code, args, message, field, masked)
+	}
+
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			code := fmt.Sprintf(strErrorCode, strCardNumberErrorCode)
+			message := fmt.Sprintf(strCardNumberErrorMessage, field)
+
+			return NewError(code, args, message, field, masked)
+		}
+	}
+
+	brand, lengths := cardBrand(digits)
+	args.Brand = brand
+
+	if !cardLuhn(digits) {
+		code := fmt.Sprintf(strErrorCode, strCardLuhnErrorCode)
+		message := fmt.Sprintf(strCardLuhnErrorMessage, field)
+
+		return NewError(code, args, message, field, masked)
+	}
+
+	if len(brands) > 0 {
+		found := false
+
+		for _, b := range brands {
+			if strings.EqualFold(b, brand) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			code := fmt.Sprintf(strErrorCode, strCardBrandErrorCode)
+			message := fmt.Sprintf(strCardBrandErrorMessage, field, brands)
+
+			return NewError(code, args, message, field, masked)
+		}
+	}
+
+	if brand == "" {
+		code := fmt.Sprintf(strErrorCode, strCardBrandErrorCode)
+		message := fmt.Sprintf(strCardBrandErrorMessage, field, "a known brand")
+
+		return NewError(code, args, message, field, masked)
+	}
+
+	for _, l := range lengths {
+		if len(digits) == l {
+			return nil
+		}
+	}
+
+	code := fmt.Sprintf(strErrorCode, strCardLengthErrorCode)
+	message := fmt.Sprintf(strCardLengthErrorMessage, field, brand)
+
+	return NewError(code, args, message, field, masked)
+}
+
+// cardBrand returns the brand and valid lengths of the card number digits, or
+// "" if no brand is found.
+func cardBrand(digits string) (string, []int) {
+	for _, b := range cardBrands {
+		for _, r := range b.ranges {
+			n := len(strconv.Itoa(r[0]))
+
+			if len(digits) < n {
+				continue
+			}
+
+			p, _ := strconv.Atoi(digits[:n])
+
+			if p >= r[0] && p <= r[1] {
+				return b.brand, b.lengths
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// cardLuhn reports whether digits pass the Luhn check.
+func cardLuhn(digits string) bool {
+	sum := 0
+
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+
+		if i%2 == 1 {
+			d *= 2
+
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// cardMask replaces the digits of value with *, except the first 6 and last 4
+// digits of numbers with at least 13 digits, or the last 4 digits of shorter
+// numbers. Other characters are kept.
+func cardMask(value string) string {
+	n := 0
+
+	for _, c := range value {
+		if c >= '0' && c <= '9' {
+			n++
+		}
+	}
+
+	first := 0
+
+	if n >= 13 {
+		first = 6
+	}
+
+	var b strings.Builder
+	i := 0
+
+	for _, c := range value {
+		if c >= '0' && c <= '9' {
+			if i >= first && i < n-4 {
+				c = '*'
+			}
+
+			i++
+		}
+
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
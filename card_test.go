@@ -0,0 +1,69 @@
+package validation
+
+import "testing"
+
+func TestStringCardNumber(t *testing.T) {
+	tests := []struct {
+		value  string
+		brands []string
+		code   string
+	}{
+		// Published test card numbers of payment processors.
+		{"4111111111111111", nil, ""},
+		{"4242 4242 4242 4242", nil, ""},
+		{"4012-8888-8888-1881", nil, ""},
+		{"4222222222222", nil, ""},
+		{"5555555555554444", nil, ""},
+		{"5105105105105100", nil, ""},
+		{"2223003122003222", nil, ""},
+		{"378282246310005", nil, ""},
+		{"371449635398431", nil, ""},
+		{"6011111111111117", nil, ""},
+		{"6011000990139424", nil, ""},
+		{"30569309025904", nil, ""},
+		{"38520000023237", nil, ""},
+		{"3530111333300000", nil, ""},
+		{"3566002020360505", nil, ""},
+		{"6200000000000005", nil, ""},
+		{"4111111111111111", []string{"visa", "mastercard"}, ""},
+		{"4111111111111111", []string{"amex"}, "ERROR_STRING_CARD_BRAND"},
+		{"4111111111111112", nil, "ERROR_STRING_CARD_LUHN"},
+		{"5555555555554445", nil, "ERROR_STRING_CARD_LUHN"},
+		{"378282246310006", nil, "ERROR_STRING_CARD_LUHN"},
+		{"4111111111111111111", nil, "ERROR_STRING_CARD_LUHN"},
+		{"4111111111119", nil, ""},
+		{"41111111111114", nil, "ERROR_STRING_CARD_LENGTH"},
+		{"9111111111111110", nil, "ERROR_STRING_CARD_BRAND"},
+		{"4111 1111 1111 111a", nil, "ERROR_STRING_CARD_NUMBER"},
+		{"", nil, "ERROR_STRING_CARD_NUMBER"},
+		{"41111111111111111111", nil, "ERROR_STRING_CARD_NUMBER"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringCardNumber("f", tt.value, tt.brands)); code != tt.code {
+			t.Errorf("StringCardNumber(%q, %v) = %q, want %q", tt.value, tt.brands, code, tt.code)
+		}
+	}
+}
+
+func TestCardLuhn(t *testing.T) {
+	tests := []struct {
+		digits string
+		valid  bool
+	}{
+		{"79927398713", true},
+		{"79927398710", false},
+		{"0", true},
+		{"18", true},
+		{"19", false},
+		{"49927398716", true},
+		{"1234567812345670", true},
+		{"1234567812345678", false},
+	}
+
+	for _, tt := range tests {
+		if valid := cardLuhn(tt.digits); valid != tt.valid {
+			t.Errorf("cardLuhn(%q) = %v, want %v", tt.digits, valid, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"fmt"
+	"unicode"
+)
+
+const (
+	strOnlyCharsetErrorCode = "ONLY_CHARSET"
+)
+
+const (
+	strOnlyCharsetErrorMessage = "%v contains character(s) not in the allowed set"
+)
+
+// Charset is a set of characters, made up of the characters in any of Tables,
+// Runes or Union. If Negate is true, the set is made up of all other
+// characters instead.
+type Charset struct {
+	Tables []*unicode.RangeTable
+	Runes  []rune
+	Union  []Charset
+	Negate bool
+}
+
+var (
+	charsetASCIIDigits = &unicode.RangeTable{
+		R16:         []unicode.Range16{{Lo: '0', Hi: '9', Stride: 1}},
+		LatinOffset: 1,
+	}
+	charsetASCIILetters = &unicode.RangeTable{
+		R16:         []unicode.Range16{{Lo: 'A', Hi: 'Z', Stride: 1}, {Lo: 'a', Hi: 'z', Stride: 1}},
+		LatinOffset: 2,
+	}
+	charsetHexLetters = &unicode.RangeTable{
+		R16:         []unicode.Range16{{Lo: 'A', Hi: 'F', Stride: 1}, {Lo: 'a', Hi: 'f', Stride: 1}},
+		LatinOffset: 2,
+	}
+	charsetASCII = &unicode.RangeTable{
+		R16:         []unicode.Range16{{Lo: 0, Hi: unicode.MaxASCII, Stride: 1}},
+		LatinOffset: 1,
+	}
+)
+
+var (
+	// CharsetASCII is the ASCII characters.
+	CharsetASCII = Charset{Tables: []*unicode.RangeTable{charsetASCII}}
+
+	// CharsetASCIIDigits is the ASCII digits 0-9.
+	CharsetASCIIDigits = Charset{Tables: []*unicode.RangeTable{charsetASCIIDigits}}
+
+	// CharsetASCIILetters is the ASCII letters A-Z and a-z.
+	CharsetASCIILetters = Charset{Tables: []*unicode.RangeTable{charsetASCIILetters}}
+
+	// CharsetASCIIAlphanumeric is the ASCII letters and digits.
+	CharsetASCIIAlphanumeric = Charset{Tables: []*unicode.RangeTable{charsetASCIILetters, charsetASCIIDigits}}
+
+	// CharsetLetters is the Unicode letters.
+	CharsetLetters = Charset{Tables: []*unicode.RangeTable{unicode.Letter}}
+
+	// CharsetAlphanumeric is the Unicode letters and digits.
+	CharsetAlphanumeric = Charset{Tables: []*unicode.RangeTable{unicode.Letter, unicode.Digit}}
+
+	// CharsetUpper is the Unicode uppercase letters.
+	CharsetUpper = Charset{Tables: []*unicode.RangeTable{unicode.Upper}}
+
+	// CharsetLower is the Unicode lowercase letters.
+	CharsetLower = Charset{Tables: []*unicode.RangeTable{unicode.Lower}}
+
+	// CharsetHex is the hexadecimal digits 0-9, A-F and a-f.
+	CharsetHex = Charset{Tables: []*unicode.RangeTable{charsetASCIIDigits, charsetHexLetters}}
+
+	// CharsetBase64 is the characters of standard base64, including the
+	// padding character =.
+	CharsetBase64 = Charset{Union: []Charset{CharsetASCIIAlphanumeric}, Runes: []rune("+/=")}
+
+	// CharsetBase64URL is the characters of URL-safe base64, including the
+	// padding character =.
+	CharsetBase64URL = Charset{Union: []Charset{CharsetASCIIAlphanumeric}, Runes: []rune("-_=")}
+
+	// CharsetPrintable is the printable characters as in unicode.IsPrint, ie.
+	// letters, marks, numbers, punctuation, symbols and the ASCII space.
+	CharsetPrintable = Charset{
+		Tables: []*unicode.RangeTable{unicode.L, unicode.M, unicode.N, unicode.P, unicode.S},
+		Runes:  []rune{' '},
+	}
+
+	// CharsetNoSpace is all characters other than Unicode white space.
+	CharsetNoSpace = Charset{Tables: []*unicode.RangeTable{unicode.White_Space}, Negate: true}
+)
+
+// Contains reports whether c is in cs.
+func (cs Charset) Contains(c rune) bool {
+	in := unicode.IsOneOf(cs.Tables, c)
+
+	for i := 0; !in && i < len(cs.Runes); i++ {
+		in = cs.Runes[i] == c
+	}
+
+	for i := 0; !in && i < len(cs.Union); i++ {
+		in = cs.Union[i].Contains(c)
+	}
+
+	return in != cs.Negate
+}
+
+// StringOnlyCharset returns error if value contains characters not in
+// charset, otherwise nil. Predefined charsets, such as CharsetHex, can be
+// combined with Union, eg. ASCII letters, digits and -_. with
+// Charset{Union: []Charset{CharsetASCIIAlphanumeric}, Runes: []rune("-_.")}.
+func StringOnlyCharset(field, value string, charset Charset) *ErrValidation {
+	for i, c := range value {
+		if !charset.Contains(c) {
+			args := struct {
+				Char  string
+				Index int
+			}{
+				string(c), i,
+			}
+			code := fmt.Sprintf(strErrorCode, strOnlyCharsetErrorCode)
+			message := fmt.Sprintf(strOnlyCharsetErrorMessage, field)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringOnlyCharsetAll is like StringOnlyCharset, but reports all characters
+// not in charset in Args, up to max of them if max>0. See InvalidChar.
+func StringOnlyCharsetAll(field, value string, charset Charset, max int) *ErrValidation {
+	return strOnlyAll(field, value, max, charset.Contains, strOnlyCharsetErrorCode, fmt.Sprintf(strOnlyCharsetErrorMessage, field))
+}
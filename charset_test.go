@@ -0,0 +1,64 @@
+package validation
+
+import "testing"
+
+func TestStringOnlyCharset(t *testing.T) {
+	slug := Charset{Union: []Charset{CharsetASCIIAlphanumeric}, Runes: []rune("-_.")}
+
+	tests := []struct {
+		name    string
+		value   string
+		charset Charset
+		code    string
+	}{
+		{"CharsetASCII", "hello, world~", CharsetASCII, ""},
+		{"CharsetASCII", "héllo", CharsetASCII, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetASCIIDigits", "0123456789", CharsetASCIIDigits, ""},
+		{"CharsetASCIIDigits", "١٢٣", CharsetASCIIDigits, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetASCIILetters", "abcXYZ", CharsetASCIILetters, ""},
+		{"CharsetASCIILetters", "abc1", CharsetASCIILetters, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetLetters", "héllo世界", CharsetLetters, ""},
+		{"CharsetAlphanumeric", "héllo 1", CharsetAlphanumeric, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetUpper", "ÉCOLE", CharsetUpper, ""},
+		{"CharsetLower", "École", CharsetLower, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetHex", "0123456789abcdefABCDEF", CharsetHex, ""},
+		{"CharsetHex", "0x1f", CharsetHex, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetBase64", "aGVsbG8+/w==", CharsetBase64, ""},
+		{"CharsetBase64", "aGVsbG8-_w==", CharsetBase64, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetBase64URL", "aGVsbG8-_w==", CharsetBase64URL, ""},
+		{"CharsetPrintable", "Hello, world! 1 + 1 = 2", CharsetPrintable, ""},
+		{"CharsetPrintable", "tab\there", CharsetPrintable, "ERROR_STRING_ONLY_CHARSET"},
+		{"CharsetNoSpace", "no-spaces", CharsetNoSpace, ""},
+		{"CharsetNoSpace", "no space", CharsetNoSpace, "ERROR_STRING_ONLY_CHARSET"},
+		{"slug", "my-file_name.txt", slug, ""},
+		{"slug", "my file", slug, "ERROR_STRING_ONLY_CHARSET"},
+		{"empty", "", Charset{}, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringOnlyCharset("f", tt.value, tt.charset)); code != tt.code {
+			t.Errorf("StringOnlyCharset(%q, %v) = %q, want %q", tt.value, tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestCharsetContains(t *testing.T) {
+	tests := []struct {
+		charset Charset
+		c       rune
+		want    bool
+	}{
+		{CharsetASCII, 0x7f, true},
+		{CharsetASCII, 0x80, false},
+		{Charset{Runes: []rune("ab")}, 'b', true},
+		{Charset{Runes: []rune("ab")}, 'c', false},
+		{Charset{Runes: []rune("ab"), Negate: true}, 'c', true},
+		{Charset{Union: []Charset{CharsetHex}, Negate: true}, 'f', false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.charset.Contains(tt.c); got != tt.want {
+			t.Errorf("%+v.Contains(%q) = %v, want %v", tt.charset, tt.c, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	strCSSColorErrorCode = "CSS_COLOR"
+)
+
+const (
+	strCSSColorErrorMessage = "%v is not a CSS color"
+)
+
+var (
+	colorHexPattern      = regexp.MustCompile(`^#([0-9a-f]{3,4}|[0-9a-f]{6}|[0-9a-f]{8})$`)
+	colorFunctionPattern = regexp.MustCompile(`^(rgba?|hsla?)\((.*)\)$`)
+	colorNumberPattern   = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)(e[+-]?\d+)?`)
+)
+
+// colorNames maps the CSS named colors to their hex values.
+var colorNames = map[string]string{
+	"aliceblue":            "#f0f8ff",
+	"antiquewhite":         "#faebd7",
+	"aqua":                 "#00ffff",
+	"aquamarine":           "#7fffd4",
+	"azure":                "#f0ffff",
+	"beige":                "#f5f5dc",
+	"bisque":               "#ffe4c4",
+	"black":                "#000000",
+	"blanchedalmond":       "#ffebcd",
+	"blue":                 "#0000ff",
+	"blueviolet":           "#8a2be2",
+	"brown":                "#a52a2a",
+	"burlywood":            "#deb887",
+	"cadetblue":            "#5f9ea0",
+	"chartreuse":           "#7fff00",
+	"chocolate":            "#d2691e",
+	"coral":                "#ff7f50",
+	"cornflowerblue":       "#6495ed",
+	"cornsilk":             "#fff8dc",
+	"crimson":              "#dc143c",
+	"cyan":                 "#00ffff",
+	"darkblue":             "#00008b",
+	"darkcyan":             "#008b8b",
+	"darkgoldenrod":        "#b8860b",
+	"darkgray":             "#a9a9a9",
+	"darkgreen":            "#006400",
+	"darkgrey":             "#a9a9a9",
+	"darkkhaki":            "#bdb76b",
+	"darkmagenta":          "#8b008b",
+	"darkolivegreen":       "#556b2f",
+	"darkorange":           "#ff8c00",
+	"darkorchid":           "#9932cc",
+	"darkred":              "#8b0000",
+	"darksalmon":           "#e9967a",
+	"darkseagreen":         "#8fbc8f",
+	"darkslateblue":        "#483d8b",
+	"darkslategray":        "#2f4f4f",
+	"darkslategrey":        "#2f4f4f",
+	"darkturquoise":        "#00ced1",
+	"darkviolet":           "#9400d3",
+	"deeppink":             "#ff1493",
+	"deepskyblue":          "#00bfff",
+	"dimgray":              "#696969",
+	"dimgrey":              "#696969",
+	"dodgerblue":           "#1e90ff",
+	"firebrick":            "#b22222",
+	"floralwhite":          "#fffaf0",
+	"forestgreen":          "#228b22",
+	"fuchsia":              "#ff00ff",
+	"gainsboro":            "#dcdcdc",
+	"ghostwhite":           "#f8f8ff",
+	"gold":                 "#ffd700",
+	"goldenrod":            "#daa520",
+	"gray":                 "#808080",
+	"green":                "#008000",
+	"greenyellow":          "#adff2f",
+	"grey":                 "#808080",
+	"honeydew":             "#f0fff0",
+	"hotpink":              "#ff69b4",
+	"indianred":            "#cd5c5c",
+	"indigo":               "#4b0082",
+	"ivory":                "#fffff0",
+	"khaki":                "#f0e68c",
+	"lavender":             "#e6e6fa",
+	"lavenderblush":        "#fff0f5",
+	"lawngreen":            "#7cfc00",
+	"lemonchiffon":         "#fffacd",
+	"lightblue":            "#add8e6",
+	"lightcoral":           "#f08080",
+	"lightcyan":            "#e0ffff",
+	"lightgoldenrodyellow": "#fafad2",
+	"lightgray":            "#d3d3d3",
+	"lightgreen":           "#90ee90",
+	"lightgrey":            "#d3d3d3",
+	"lightpink":            "#ffb6c1",
+	"lightsalmon":          "#ffa07a",
+	"lightseagreen":        "#20b2aa",
+	"lightskyblue":         "#87cefa",
+	"lightslategray":       "#778899",
+	"lightslategrey":       "#778899",
+	"lightsteelblue":       "#b0c4de",
+	"lightyellow":          "#ffffe0",
+	"lime":                 "#00ff00",
+	"limegreen":            "#32cd32",
+	"linen":                "#faf0e6",
+	"magenta":              "#ff00ff",
+	"maroon":               "#800000",
+	"mediumaquamarine":     "#66cdaa",
+	"mediumblue":           "#0000cd",
+	"mediumorchid":         "#ba55d3",
+	"mediumpurple":         "#9370db",
+	"mediumseagreen":       "#3cb371",
+	"mediumslateblue":      "#7b68ee",
+	"mediumspringgreen":    "#00fa9a",
+	"mediumturquoise":      "#48d1cc",
+	"mediumvioletred":      "#c71585",
+	"midnightblue":         "#191970",
+	"mintcream":            "#f5fffa",
+	"mistyrose":            "#ffe4e1",
+	"moccasin":             "#ffe4b5",
+	"navajowhite":          "#ffdead",
+	"navy":                 "#000080",
+	"oldlace":              "#fdf5e6",
+	"olive":                "#808000",
+	"olivedrab":            "#6b8e23",
+	"orange":               "#ffa500",
+	"orangered":            "#ff4500",
+	"orchid":               "#da70d6",
+	"palegoldenrod":        "#eee8aa",
+	"palegreen":            "#98fb98",
+	"paleturquoise":        "#afeeee",
+	"palevioletred":        "#db7093",
+	"papayawhip":           "#ffefd5",
+	"peachpuff":            "#ffdab9",
+	"peru":                 "#cd853f",
+	"pink":                 "#ffc0cb",
+	"plum":                 "#dda0dd",
+	"powderblue":           "#b0e0e6",
+	"purple":               "#800080",
+	"rebeccapurple":        "#663399",
+	"red":                  "#ff0000",
+	"rosybrown":            "#bc8f8f",
+	"royalblue":            "#4169e1",
+	"saddlebrown":          "#8b4513",
+	"salmon":               "#fa8072",
+	"sandybrown":           "#f4a460",
+	"seagreen":             "#2e8b57",
+	"seashell":             "#fff5ee",
+	"sienna":               "#a0522d",
+	"silver":               "#c0c0c0",
+	"skyblue":              "#87ceeb",
+	"slateblue":            "#6a5acd",
+	"slategray":            "#708090",
+	"slategrey":            "#708090",
+	"snow":                 "#fffafa",
+	"springgreen":          "#00ff7f",
+	"steelblue":            "#4682b4",
+	"tan":                  "#d2b48c",
+	"teal":                 "#008080",
+	"thistle":              "#d8bfd8",
+	"tomato":               "#ff6347",
+	"turquoise":            "#40e0d0",
+	"violet":               "#ee82ee",
+	"wheat":                "#f5deb3",
+	"white":                "#ffffff",
+	"whitesmoke":           "#f5f5f5",
+	"yellow":               "#ffff00",
+	"yellowgreen":          "#9acd32",
+}
+
+// StringCSSColor returns error if value is not a CSS color, otherwise nil. See
+// CSSColor.
+func StringCSSColor(field, value string) *ErrValidation {
+	_, err := CSSColor(field, value)
+
+	return err
+}
+
+// CSSColor returns value as a lowercase CSS hex color, eg. #ff0000, or with
+// alpha if it is not opaque, eg. #ff000080, or error if value is not a CSS
+// color. Accepted are hex colors, the rgb(), rgba(), hsl() and hsla()
+// functions in both the comma-separated and space-separated syntax, named
+// colors and transparent, case-insensitively. currentcolor is returned as is.
+// Out of range components are clamped as in CSS.
+func CSSColor(field, value string) (string, *ErrValidation) {
+	v := strings.ToLower(strings.TrimSpace(value))
+
+	if v == "currentcolor" {
+		return v, nil
+	}
+
+	if v == "transparent" {
+		return "#00000000", nil
+	}
+
+	if hex, ok := colorNames[v]; ok {
+		return hex, nil
+	}
+
+	if colorHexPattern.MatchString(v) {
+		if len(v) <= 5 {
+			var b strings.Builder
+
+			for _, c := range v[1:] {
+				b.WriteRune(c)
+				b.WriteRune(c)
+			}
+
+			v = "#" + b.String()
+		}
+
+		if len(v) == 9 && strings.HasSuffix(v, "ff") {
+			v = v[:7]
+		}
+
+		return v, nil
+	}
+
+	if rgba, ok := colorFunction(v); ok {
+		return colorHex(rgba), nil
+	}
+
+	code := fmt.Sprintf(strErrorCode, strCSSColorErrorCode)
+	message := fmt.Sprintf(strCSSColorErrorMessage, field)
+
+	return "", NewError(code, struct{}{}, message, field, value)
+}
+
+// colorFunction parses v, a lowercase rgb(), rgba(), hsl() or hsla() color,
+// returning its red, green, blue and alpha in the range 0 to 1.
+func colorFunction(v string) ([4]float64, bool) {
+	m := colorFunctionPattern.FindStringSubmatch(v)
+
+	if m == nil {
+		return [4]float64{}, false
+	}
+
+	var args []string
+	legacy := strings.Contains(m[2], ",")
+
+	if legacy {
+		for _, a := range strings.Split(m[2], ",") {
+			args = append(args, strings.TrimSpace(a))
+		}
+	} else {
+		parts := strings.Split(m[2], "/")
+		args = strings.Fields(parts[0])
+
+		if len(parts) > 2 || len(args) != 3 {
+			return [4]float64{}, false
+		}
+
+		if len(parts) == 2 {
+			alpha := strings.Fields(parts[1])
+
+			if len(alpha) != 1 {
+				return [4]float64{}, false
+			}
+
+			args = append(args, alpha[0])
+		}
+	}
+
+	if len(args) != 3 && len(args) != 4 {
+		return [4]float64{}, false
+	}
+
+	var c [4]float64
+	var ok bool
+
+	if strings.HasPrefix(m[1], "rgb") {
+		c, ok = colorRGB(args[:3], legacy)
+	} else {
+		c, ok = colorHSL(args[:3], legacy)
+	}
+
+	if !ok {
+		return [4]float64{}, false
+	}
+
+	c[3] = 1
+
+	if len(args) == 4 {
+		n, unit, ok := colorNumber(args[3])
+
+		if !ok || unit != "" && unit != "%" {
+			return [4]float64{}, false
+		}
+
+		if unit == "%" {
+			n /= 100
+		}
+
+		c[3] = colorClamp(n)
+	}
+
+	return c, true
+}
+
+// colorRGB parses the red, green and blue of an rgb() color, either all
+// numbers from 0 to 255 or all percentages in the comma-separated syntax.
+func colorRGB(args []string, legacy bool) ([4]float64, bool) {
+	var c [4]float64
+	units := make(map[string]struct{})
+
+	for i, a := range args {
+		n, unit, ok := colorNumber(a)
+
+		if !ok || unit != "" && unit != "%" {
+			return c, false
+		}
+
+		units[unit] = struct{}{}
+
+		if unit == "%" {
+			c[i] = colorClamp(n / 100)
+		} else {
+			c[i] = colorClamp(n / 255)
+		}
+	}
+
+	return c, !legacy || len(units) == 1
+}
+
+// colorHSL parses the hue, saturation and lightness of an hsl() color, and
+// converts them to red, green and blue. The hue may be in deg, grad, rad or
+// turn, and saturation and lightness must be percentages in the
+// comma-separated syntax.
+func colorHSL(args []string, legacy bool) ([4]float64, bool) {
+	var c [4]float64
+	h, unit, ok := colorNumber(args[0])
+
+	if !ok {
+		return c, false
+	}
+
+	switch unit {
+	case "", "deg":
+	case "grad":
+		h = h * 360 / 400
+	case "rad":
+		h = h * 180 / math.Pi
+	case "turn":
+		h *= 360
+	default:
+		return c, false
+	}
+
+	var sl [2]float64
+
+	for i, a := range args[1:] {
+		n, unit, ok := colorNumber(a)
+
+		if !ok || unit != "%" && (legacy || unit != "") {
+			return c, false
+		}
+
+		sl[i] = colorClamp(n / 100)
+	}
+
+	h = math.Mod(math.Mod(h, 360)+360, 360) / 360
+	s, l := sl[0], sl[1]
+	q := l + s - l*s
+
+	if l < 0.5 {
+		q = l * (1 + s)
+	}
+
+	p := 2*l - q
+
+	for i, t := range []float64{h + 1.0/3, h, h - 1.0/3} {
+		t = math.Mod(t+1, 1)
+
+		switch {
+		case t < 1.0/6:
+			c[i] = p + (q-p)*6*t
+		case t < 1.0/2:
+			c[i] = q
+		case t < 2.0/3:
+			c[i] = p + (q-p)*(2.0/3-t)*6
+		default:
+			c[i] = p
+		}
+	}
+
+	return c, true
+}
+
+// colorNumber parses a CSS number followed by an optional unit, eg. 50% or
+// 0.5turn.
+func colorNumber(s string) (float64, string, bool) {
+	loc := colorNumberPattern.FindStringIndex(s)
+
+	if loc == nil {
+		return 0, "", false
+	}
+
+	n, err := strconv.ParseFloat(s[:loc[1]], 64)
+
+	if err != nil {
+		return 0, "", false
+	}
+
+	return n, s[loc[1]:], true
+}
+
+// colorClamp clamps n to the range 0 to 1.
+func colorClamp(n float64) float64 {
+	return math.Max(0, math.Min(1, n))
+}
+
+// colorHex formats c as a hex color, with alpha if it is not opaque.
+func colorHex(c [4]float64) string {
+	var b strings.Builder
+
+	b.WriteByte('#')
+
+	for i, n := range c {
+		if i < 3 || n < 1 {
+			fmt.Fprintf(&b, "%02x", int(math.Round(n*255)))
+		}
+	}
+
+	return b.String()
+}
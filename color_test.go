@@ -0,0 +1,46 @@
+package validation
+
+import "testing"
+
+func TestCSSColor(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		code  string
+	}{
+		{"#FF0000", "#ff0000", ""},
+		{"#f00", "#ff0000", ""},
+		{"#f008", "#ff000088", ""},
+		{"#ff0000ff", "#ff0000", ""},
+		{"Red", "#ff0000", ""},
+		{"transparent", "#00000000", ""},
+		{"currentColor", "currentcolor", ""},
+		{"rgb(255, 0, 0)", "#ff0000", ""},
+		{"rgba(255, 0, 0, 0.5)", "#ff000080", ""},
+		{"rgb(100%, 0%, 0%)", "#ff0000", ""},
+		{"rgb(255 0 0 / 50%)", "#ff000080", ""},
+		{"rgb(300, -5, 0)", "#ff0000", ""},
+		{"hsl(120, 100%, 50%)", "#00ff00", ""},
+		{"hsl(0.5turn 100% 50%)", "#00ffff", ""},
+		{"hsla(240, 100%, 50%, 1)", "#0000ff", ""},
+		{"rgb(255, 0%, 0)", "", "ERROR_STRING_CSS_COLOR"},
+		{"rgb(255 0 0 0)", "", "ERROR_STRING_CSS_COLOR"},
+		{"hsl(120, 100, 50)", "", "ERROR_STRING_CSS_COLOR"},
+		{"#ff00", "#ffff0000", ""},
+		{"#ff000", "", "ERROR_STRING_CSS_COLOR"},
+		{"reddish", "", "ERROR_STRING_CSS_COLOR"},
+		{"", "", "ERROR_STRING_CSS_COLOR"},
+	}
+
+	for _, tt := range tests {
+		got, err := CSSColor("f", tt.value)
+
+		if code := errCode(err); got != tt.want || code != tt.code {
+			t.Errorf("CSSColor(%q) = %q, %q, want %q, %q", tt.value, got, code, tt.want, tt.code)
+		}
+
+		if code := errCode(StringCSSColor("f", tt.value)); code != tt.code {
+			t.Errorf("StringCSSColor(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
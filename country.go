@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Components of country-specific identifiers reported in the Component arg.
+const (
+	countryComponentCountry   = "country"
+	countryComponentFormat    = "format"
+	countryComponentChecksum  = "checksum"
+	countryComponentBirthDate = "birth_date"
+	countryComponentRegion    = "region"
+)
+
+// CountryRule checks a country-specific identifier, such as a bank account
+// number or a postal code, with spaces and hyphens removed and letters in
+// uppercase. It returns the failing component, eg. "format" or "checksum", or
+// "" if value is valid.
+type CountryRule func(value string) string
+
+// countryRules is a set of CountryRule keyed by ISO 3166-1 alpha-2 codes, safe
+// for concurrent use.
+type countryRules struct {
+	mu    sync.RWMutex
+	rules map[string]CountryRule
+}
+
+// get returns the rule of country.
+func (r *countryRules) get(country string) (CountryRule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rule, ok := r.rules[strings.ToUpper(country)]
+
+	return rule, ok
+}
+
+// set sets the rule of country, replacing any existing rule.
+func (r *countryRules) set(country string, rule CountryRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rules[strings.ToUpper(country)] = rule
+}
+
+// countryCheck checks value with the rule of country in rules, and returns
+// error with code and message if value fails or country has no rule.
+func countryCheck(field, value, country string, rules *countryRules, code, message string) *ErrValidation {
+	country = strings.ToUpper(country)
+
+	args := struct {
+		Country   string
+		Component string
+	}{
+		country, countryComponentCountry,
+	}
+
+	if rule, ok := rules.get(country); ok {
+		args.Component = rule(countryNormalize(value))
+	}
+
+	if args.Component != "" {
+		code = fmt.Sprintf(strErrorCode, code)
+		message = fmt.Sprintf(message, field, country)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// countryNormalize removes spaces and hyphens from value and converts letters
+// to uppercase.
+func countryNormalize(value string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(value))
+}
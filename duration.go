@@ -0,0 +1,285 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	durErrorCode = "ERROR_DURATION_%v"
+)
+
+const (
+	durFormatErrorCode      = "FORMAT"
+	durMinErrorCode         = "MIN"
+	durMaxErrorCode         = "MAX"
+	durBetweenErrorCode     = "BETWEEN"
+	durGranularityErrorCode = "GRANULARITY"
+)
+
+const (
+	durFormatErrorMessage      = "%v is not a duration"
+	durMinErrorMessage         = "%v is shorter than %v"
+	durMaxErrorMessage         = "%v is longer than %v"
+	durBetweenErrorMessage     = "%v is not between %v and %v"
+	durGranularityErrorMessage = "%v is not a whole number of %v"
+)
+
+// errDurOutOfRange is returned when an ISO 8601 duration is too long for
+// time.Duration.
+var errDurOutOfRange = errors.New("ISO 8601 duration out of range")
+
+// durISO8601Pattern matches ISO 8601 durations made up of weeks, days, hours,
+// minutes and seconds, eg. PT15M, P1DT12H. Years and months are not accepted
+// as they have no fixed length.
+var durISO8601Pattern = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// durUnits are the units used to describe durations in error messages, from
+// the largest to the smallest.
+var durUnits = []struct {
+	d    time.Duration
+	name string
+}{
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+	{time.Second, "second"},
+	{time.Millisecond, "millisecond"},
+	{time.Microsecond, "microsecond"},
+	{time.Nanosecond, "nanosecond"},
+}
+
+// DurationFormat returns error if value is neither a Go duration, eg. 1h30m,
+// nor an ISO 8601 duration, eg. PT1H30M, otherwise nil.
+func DurationFormat(field, value string) *ErrValidation {
+	_, err := durParse(field, value)
+
+	return err
+}
+
+// DurationMin returns error if value<min, otherwise nil.
+func DurationMin(field, value string, min time.Duration) *ErrValidation {
+	d, err := durParse(field, value)
+
+	if err != nil {
+		return err
+	}
+
+	if d < min {
+		args := struct {
+			Min time.Duration
+		}{
+			min,
+		}
+		code := fmt.Sprintf(durErrorCode, durMinErrorCode)
+		message := fmt.Sprintf(durMinErrorMessage, field, durString(min))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// DurationMax returns error if value>max, otherwise nil.
+func DurationMax(field, value string, max time.Duration) *ErrValidation {
+	d, err := durParse(field, value)
+
+	if err != nil {
+		return err
+	}
+
+	if d > max {
+		args := struct {
+			Max time.Duration
+		}{
+			max,
+		}
+		code := fmt.Sprintf(durErrorCode, durMaxErrorCode)
+		message := fmt.Sprintf(durMaxErrorMessage, field, durString(max))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// DurationBetween returns error if value<min or value>max, otherwise nil.
+func DurationBetween(field, value string, min, max time.Duration) *ErrValidation {
+	d, err := durParse(field, value)
+
+	if err != nil {
+		return err
+	}
+
+	if d < min || d > max {
+		args := struct {
+			Min, Max time.Duration
+		}{
+			min, max,
+		}
+		code := fmt.Sprintf(durErrorCode, durBetweenErrorCode)
+		message := fmt.Sprintf(durBetweenErrorMessage, field, durString(min), durString(max))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// DurationGranularity returns error if value is not a whole number of unit,
+// eg. 90s is a whole number of seconds but not of minutes, otherwise nil.
+// DurationGranularity panics if unit<=0.
+func DurationGranularity(field, value string, unit time.Duration) *ErrValidation {
+	if unit <= 0 {
+		panic("unit must be greater than 0")
+	}
+
+	d, err := durParse(field, value)
+
+	if err != nil {
+		return err
+	}
+
+	if d%unit != 0 {
+		args := struct {
+			Unit      time.Duration
+			Remainder time.Duration
+		}{
+			unit, d % unit,
+		}
+		code := fmt.Sprintf(durErrorCode, durGranularityErrorCode)
+		message := fmt.Sprintf(durGranularityErrorMessage, field, durUnitString(unit))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// durParse parses value as a Go duration or an ISO 8601 duration, and returns
+// error if value cannot be parsed.
+func durParse(field, value string) (time.Duration, *ErrValidation) {
+	d, err := time.ParseDuration(value)
+
+	if err != nil {
+		d, err = durParseISO8601(value)
+	}
+
+	if err != nil {
+		args := struct{}{}
+		code := fmt.Sprintf(durErrorCode, durFormatErrorCode)
+		message := fmt.Sprintf(durFormatErrorMessage, field)
+
+		return 0, NewError(code, args, message, field, value)
+	}
+
+	return d, nil
+}
+
+// durParseISO8601 parses value as an ISO 8601 duration.
+func durParseISO8601(value string) (time.Duration, error) {
+	m := durISO8601Pattern.FindStringSubmatch(value)
+
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, errors.New("invalid ISO 8601 duration")
+	}
+
+	var d time.Duration
+
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute} {
+		if m[i+1] == "" {
+			continue
+		}
+
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if n > math.MaxInt64/int64(unit) {
+			return 0, errDurOutOfRange
+		}
+
+		if d, err = durAdd(d, time.Duration(n)*unit); err != nil {
+			return 0, err
+		}
+	}
+
+	if m[5] != "" {
+		s, err := strconv.ParseFloat(strings.Replace(m[5], ",", ".", 1), 64)
+
+		if err != nil {
+			return 0, err
+		}
+
+		// float64(math.MaxInt64) rounds up to 2^63, which is out of range.
+		if s*float64(time.Second) >= math.MaxInt64 {
+			return 0, errDurOutOfRange
+		}
+
+		if d, err = durAdd(d, time.Duration(s*float64(time.Second))); err != nil {
+			return 0, err
+		}
+	}
+
+	return d, nil
+}
+
+// durAdd returns d+n, where d and n are not negative, and error if the sum
+// overflows.
+func durAdd(d, n time.Duration) (time.Duration, error) {
+	if d > math.MaxInt64-n {
+		return 0, errDurOutOfRange
+	}
+
+	return d + n, nil
+}
+
+// durString describes d in words, eg. 1 hour 30 minutes.
+func durString(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	var parts []string
+
+	if d < 0 {
+		parts = append(parts, "minus")
+		d = -d
+	}
+
+	for _, u := range durUnits {
+		if n := d / u.d; n > 0 {
+			parts = append(parts, durPlural(int64(n), u.name))
+			d -= n * u.d
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// durUnitString describes unit in words, eg. minutes or 15 minutes.
+func durUnitString(unit time.Duration) string {
+	for _, u := range durUnits {
+		if unit == u.d {
+			return u.name + "s"
+		}
+	}
+
+	return durString(unit)
+}
+
+// durPlural returns n followed by name, pluralised if n!=1.
+func durPlural(n int64, name string) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %v", n, name)
+	}
+
+	return fmt.Sprintf("%v %vs", n, name)
+}
@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationMaxOverflow(t *testing.T) {
+	tests := []struct {
+		value string
+		max   time.Duration
+		code  string
+	}{
+		{"PT1H", time.Hour, ""},
+		{"P1W", 7 * 24 * time.Hour, ""},
+		{"PT9223372036S", time.Duration(1<<63 - 1), ""},
+		{"PT9223372037S", time.Hour, "ERROR_DURATION_FORMAT"},
+		{"PT99999999999999999999S", time.Hour, "ERROR_DURATION_FORMAT"},
+		{"P20000W", 1000000 * time.Hour, "ERROR_DURATION_FORMAT"},
+		{"P15250W", 1000000 * time.Hour, "ERROR_DURATION_MAX"},
+		{"P15251W", 1000000 * time.Hour, "ERROR_DURATION_FORMAT"},
+		{"P15000WT9223372036S", time.Duration(1<<63 - 1), "ERROR_DURATION_FORMAT"},
+		{"PT2562047H", time.Duration(1<<63 - 1), ""},
+		{"PT2562048H", time.Duration(1<<63 - 1), "ERROR_DURATION_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		err := DurationMax("f", tt.value, tt.max)
+
+		if code := errCode(err); code != tt.code {
+			t.Errorf("DurationMax(%q, %v) = %v, want %q", tt.value, tt.max, err, tt.code)
+		}
+	}
+}
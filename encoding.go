@@ -0,0 +1,318 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	strJSONErrorCode       = "JSON"
+	strJSONKindErrorCode   = "JSON_KIND"
+	strJSONDepthErrorCode  = "JSON_DEPTH"
+	strBase64ErrorCode     = "BASE64"
+	strBase64SizeErrorCode = "BASE64_SIZE"
+	strHexErrorCode        = "HEX"
+	strHexLengthErrorCode  = "HEX_LENGTH"
+	strJWTErrorCode        = "JWT"
+)
+
+const (
+	strJSONErrorMessage       = "%v is not valid JSON"
+	strJSONKindErrorMessage   = "%v is not a JSON %v"
+	strJSONDepthErrorMessage  = "%v is nested deeper than %v"
+	strBase64ErrorMessage     = "%v is not valid base64"
+	strBase64SizeErrorMessage = "decoded size of %v is greater than %v bytes"
+	strHexErrorMessage        = "%v is not valid hex"
+	strHexLengthErrorMessage  = "decoded length of %v is not %v bytes"
+	strJWTErrorMessage        = "%v is not a JWT"
+)
+
+// encodingJSONKinds are the kinds of JSON values accepted by StringJSON.
+var encodingJSONKinds = map[string]struct{}{
+	"object": {}, "array": {}, "string": {}, "number": {}, "boolean": {}, "null": {},
+}
+
+// StringJSON returns error if value is not well-formed JSON, if kind!="" and
+// the top-level value is not of kind, one of object, array, string, number,
+// boolean or null, or if maxDepth>0 and objects and arrays are nested deeper
+// than maxDepth, otherwise nil. The byte offset of a syntax error is reported
+// in Args. StringJSON panics if kind is unknown.
+func StringJSON(field, value, kind string, maxDepth int) *ErrValidation {
+	if _, ok := encodingJSONKinds[kind]; !ok && kind != "" {
+		panic("unknown JSON kind " + kind)
+	}
+
+	if offset, ok := encodingJSONSyntax([]byte(value)); !ok {
+		args := struct {
+			Offset int64
+		}{
+			offset,
+		}
+		code := fmt.Sprintf(strErrorCode, strJSONErrorCode)
+		message := fmt.Sprintf(strJSONErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	found, depth := encodingJSONShape([]byte(value))
+
+	if kind != "" && found != kind {
+		args := struct {
+			Kind  string
+			Found string
+		}{
+			kind, found,
+		}
+		code := fmt.Sprintf(strErrorCode, strJSONKindErrorCode)
+		message := fmt.Sprintf(strJSONKindErrorMessage, field, kind)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if maxDepth > 0 && depth > maxDepth {
+		args := struct {
+			MaxDepth int
+			Depth    int
+		}{
+			maxDepth, depth,
+		}
+		code := fmt.Sprintf(strErrorCode, strJSONDepthErrorCode)
+		message := fmt.Sprintf(strJSONDepthErrorMessage, field, maxDepth)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringBase64 returns error if value is not valid base64, either standard or
+// URL-safe if urlSafe is true, or if maxSize>0 and the decoded value is greater
+// than maxSize bytes, otherwise nil. Padding is optional, but must be correct
+// if present. The byte offset of the first invalid character is reported in
+// Args.
+func StringBase64(field, value string, urlSafe bool, maxSize int) *ErrValidation {
+	size, offset, ok := encodingBase64(value, urlSafe)
+
+	if !ok {
+		args := struct {
+			Offset int64
+		}{
+			offset,
+		}
+		code := fmt.Sprintf(strErrorCode, strBase64ErrorCode)
+		message := fmt.Sprintf(strBase64ErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if maxSize > 0 && size > maxSize {
+		args := struct {
+			MaxSize int
+			Size    int
+		}{
+			maxSize, size,
+		}
+		code := fmt.Sprintf(strErrorCode, strBase64SizeErrorCode)
+		message := fmt.Sprintf(strBase64SizeErrorMessage, field, maxSize)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringHex returns error if value is not valid hex, ie. an even number of
+// hexadecimal digits, or if length>0 and value does not decode to length
+// bytes, otherwise nil. The byte offset of the first invalid character, or the
+// length of value if it is odd, is reported in Args.
+func StringHex(field, value string, length int) *ErrValidation {
+	offset := strings.IndexFunc(value, func(c rune) bool {
+		return !CharsetHex.Contains(c)
+	})
+
+	if offset < 0 && len(value)%2 != 0 {
+		offset = len(value)
+	}
+
+	if offset >= 0 {
+		args := struct {
+			Offset int64
+		}{
+			int64(offset),
+		}
+		code := fmt.Sprintf(strErrorCode, strHexErrorCode)
+		message := fmt.Sprintf(strHexErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if length > 0 && len(value)/2 != length {
+		args := struct {
+			Length int
+			Actual int
+		}{
+			length, len(value) / 2,
+		}
+		code := fmt.Sprintf(strErrorCode, strHexLengthErrorCode)
+		message := fmt.Sprintf(strHexLengthErrorMessage, field, length)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringJWT returns error if value is not a JWT in JWS compact serialization,
+// ie. header.payload.signature, where each segment is unpadded URL-safe base64
+// and the header decodes to a JSON object, otherwise nil. The signature is
+// not verified, and may be empty for unsecured JWTs. The failing Segment and
+// the byte offset of the error within it are reported in Args.
+func StringJWT(field, value string) *ErrValidation {
+	code := fmt.Sprintf(strErrorCode, strJWTErrorCode)
+	message := fmt.Sprintf(strJWTErrorMessage, field)
+	segments := strings.Split(value, ".")
+
+	if len(segments) != 3 {
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	var header []byte
+
+	for i, name := range []string{"header", "payload", "signature"} {
+		b, offset, ok := encodingDecode(base64.RawURLEncoding, segments[i])
+
+		if !ok || i < 2 && segments[i] == "" {
+			args := struct {
+				Segment string
+				Offset  int64
+			}{
+				name, offset,
+			}
+
+			return NewError(code, args, message, field, value)
+		}
+
+		if i == 0 {
+			header = b
+		}
+	}
+
+	offset, ok := encodingJSONSyntax(header)
+
+	if kind, _ := encodingJSONShape(header); !ok || kind != "object" {
+		args := struct {
+			Segment string
+			Offset  int64
+		}{
+			"header", offset,
+		}
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// encodingJSONSyntax returns the byte offset of the syntax error in data, and
+// whether data is well-formed JSON.
+func encodingJSONSyntax(data []byte) (int64, bool) {
+	if json.Valid(data) {
+		return 0, true
+	}
+
+	var v interface{}
+	var e *json.SyntaxError
+
+	if err := json.Unmarshal(data, &v); errors.As(err, &e) {
+		return e.Offset, false
+	}
+
+	return int64(len(data)), false
+}
+
+// encodingJSONShape returns the kind of the top-level value of data, which
+// must be well-formed JSON, and the depth of nesting of its objects and arrays.
+func encodingJSONShape(data []byte) (string, int) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	kind, depth, max := "", 0, 0
+
+	for {
+		t, err := d.Token()
+
+		if err != nil {
+			return kind, max
+		}
+
+		if kind == "" {
+			switch v := t.(type) {
+			case json.Delim:
+				kind = "array"
+
+				if v == '{' {
+					kind = "object"
+				}
+			case string:
+				kind = "string"
+			case json.Number:
+				kind = "number"
+			case bool:
+				kind = "boolean"
+			default:
+				kind = "null"
+			}
+		}
+
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+
+			if depth > max {
+				max = depth
+			}
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+}
+
+// encodingBase64 returns the decoded size of value, the byte offset of the
+// first invalid character, and whether value is valid base64, standard or
+// URL-safe if urlSafe is true, with optional padding.
+func encodingBase64(value string, urlSafe bool) (int, int64, bool) {
+	enc := base64.StdEncoding
+
+	if urlSafe {
+		enc = base64.URLEncoding
+	}
+
+	if !strings.HasSuffix(value, "=") && len(value)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+
+	b, offset, ok := encodingDecode(enc.Strict(), value)
+
+	return len(b), offset, ok
+}
+
+// encodingDecode decodes value with enc, returning the decoded bytes, the byte
+// offset of the first invalid character, and whether value is valid. Unlike
+// enc.DecodeString, newlines are rejected.
+func encodingDecode(enc *base64.Encoding, value string) ([]byte, int64, bool) {
+	if i := strings.IndexAny(value, "\r\n"); i >= 0 {
+		return nil, int64(i), false
+	}
+
+	b, err := enc.DecodeString(value)
+
+	if e, ok := err.(base64.CorruptInputError); ok {
+		return nil, int64(e), false
+	}
+
+	return b, 0, err == nil
+}
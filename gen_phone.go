@@ -0,0 +1,162 @@
+//go:build ignore
+
+// gen_phone generates phone_metadata.go from phone_metadata.txt.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type rule struct {
+	kind     string
+	prefixes []string
+	lengths  []int
+}
+
+type region struct {
+	code, trunk string
+	rules       []rule
+}
+
+func main() {
+	f, err := os.Open("phone_metadata.txt")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer f.Close()
+
+	regions := make(map[string]*region)
+	codes := make(map[string][]string)
+	var order []string
+
+	s := bufio.NewScanner(f)
+
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cols := strings.Split(line, ";")
+
+		if len(cols) != 6 {
+			log.Fatalf("line %v: expected 6 columns, got %v", n, len(cols))
+		}
+
+		name, code, trunk := cols[0], cols[1], cols[2]
+		r, ok := regions[name]
+
+		if !ok {
+			r = &region{code: code, trunk: trunk}
+			regions[name] = r
+			codes[code] = append(codes[code], name)
+			order = append(order, name)
+		} else if r.code != code || r.trunk != trunk {
+			log.Fatalf("line %v: calling code or trunk prefix of %v differs from previous lines", n, name)
+		}
+
+		lengths, err := parseLengths(cols[5])
+
+		if err != nil {
+			log.Fatalf("line %v: %v", n, err)
+		}
+
+		r.rules = append(r.rules, rule{cols[3], strings.Split(cols[4], ","), lengths})
+	}
+
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	sort.Strings(order)
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen_phone.go from phone_metadata.txt. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validation")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var phoneRegions = map[string]phoneRegion{")
+
+	for _, name := range order {
+		r := regions[name]
+
+		fmt.Fprintf(&b, "%q: {%q, %q, []phoneRule{\n", name, r.code, r.trunk)
+
+		for _, ru := range r.rules {
+			fmt.Fprintf(&b, "{%q, %#v, %#v},\n", ru.kind, ru.prefixes, ru.lengths)
+		}
+
+		fmt.Fprintln(&b, "}},")
+	}
+
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var phoneCallingCodes = map[string][]string{")
+
+	var keys []string
+
+	for k := range codes {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%q: %#v,\n", k, codes[k])
+	}
+
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("phone_metadata.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseLengths parses comma-separated lengths or ranges of lengths, eg. 8,10-12.
+func parseLengths(s string) ([]int, error) {
+	var lengths []int
+
+	for _, v := range strings.Split(s, ",") {
+		lo, hi := v, v
+
+		if i := strings.Index(v, "-"); i >= 0 {
+			lo, hi = v[:i], v[i+1:]
+		}
+
+		m, err := strconv.Atoi(lo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := strconv.Atoi(hi)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := m; i <= n; i++ {
+			lengths = append(lengths, i)
+		}
+	}
+
+	return lengths, nil
+}
@@ -0,0 +1,115 @@
+//go:build ignore
+
+// gen_unicode generates unicode_confusables.go from unicode_confusables.txt.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	f, err := os.Open("unicode_confusables.txt")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer f.Close()
+
+	confusables := make(map[rune]string)
+
+	s := bufio.NewScanner(f)
+
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimPrefix(s.Text(), "\ufeff")
+
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		cols := strings.Split(line, ";")
+
+		if len(cols) != 3 {
+			log.Fatalf("line %v: expected 3 columns, got %v", n, len(cols))
+		}
+
+		source, err := parseRunes(cols[0])
+
+		if err != nil || len(source) != 1 {
+			log.Fatalf("line %v: invalid source %q", n, cols[0])
+		}
+
+		target, err := parseRunes(cols[1])
+
+		if err != nil {
+			log.Fatalf("line %v: %v", n, err)
+		}
+
+		confusables[source[0]] = string(target)
+	}
+
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	var keys []rune
+
+	for k := range confusables {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen_unicode.go from unicode_confusables.txt. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validation")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var unicodeConfusables = map[rune]string{")
+
+	for _, k := range keys {
+		fmt.Fprintf(&b, "0x%04X: %+q,\n", k, confusables[k])
+	}
+
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("unicode_confusables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseRunes parses space-separated hexadecimal code points, eg. 0072 006E.
+func parseRunes(s string) ([]rune, error) {
+	var rs []rune
+
+	for _, v := range strings.Fields(s) {
+		n, err := strconv.ParseUint(v, 16, 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rs = append(rs, rune(n))
+	}
+
+	return rs, nil
+}
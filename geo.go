@@ -0,0 +1,309 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	geoErrorCode = "ERROR_GEO_%v"
+)
+
+const (
+	geoBoundingBoxErrorCode    = "BOUNDING_BOX"
+	geoPolygonErrorCode        = "POLYGON"
+	geoJSONErrorCode           = "GEOJSON"
+	geoJSONPositionErrorCode   = "GEOJSON_POSITION"
+	geoJSONRingClosedErrorCode = "GEOJSON_RING_NOT_CLOSED"
+	geoJSONWindingErrorCode    = "GEOJSON_WINDING_ORDER"
+)
+
+const (
+	geoBoundingBoxErrorMessage    = "%v is not within %v"
+	geoPolygonErrorMessage        = "%v is not within the polygon"
+	geoJSONErrorMessage           = "%v is not a GeoJSON geometry"
+	geoJSONPositionErrorMessage   = "%v has an invalid position at %v"
+	geoJSONRingClosedErrorMessage = "%v has a ring not closed at %v"
+	geoJSONWindingErrorMessage    = "%v has a ring with the wrong winding order at %v"
+)
+
+// geoJSON is a GeoJSON geometry, whose coordinates are decoded according to
+// Type.
+type geoJSON struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+}
+
+// GeoLatitude returns error if value is NaN or not between -90 and 90, as
+// NumberNotANumber and NumberBetween, otherwise nil.
+func GeoLatitude(field string, value float64) *ErrValidation {
+	if err := NumberNotANumber(field, value); err != nil {
+		return err
+	}
+
+	return NumberBetween(field, value, -90.0, 90.0)
+}
+
+// GeoLongitude returns error if value is NaN or not between -180 and 180, as
+// NumberNotANumber and NumberBetween, otherwise nil.
+func GeoLongitude(field string, value float64) *ErrValidation {
+	if err := NumberNotANumber(field, value); err != nil {
+		return err
+	}
+
+	return NumberBetween(field, value, -180.0, 180.0)
+}
+
+// GeoPrecision returns error if value has more than max decimal places, as
+// NumberFormat with the format 0,max, otherwise nil. 5 decimal places of a
+// coordinate are a precision of about 1 m.
+func GeoPrecision(field string, value float64, max int) *ErrValidation {
+	return NumberFormat(field, value, fmt.Sprintf("0,%v", max))
+}
+
+// GeoInBoundingBox returns error if the point lng,lat is not within bbox,
+// otherwise nil. bbox is as in GeoJSON, ie. west, south, east and north, and
+// crosses the antimeridian if west>east. Points on the edges are within bbox.
+func GeoInBoundingBox(field string, lng, lat float64, bbox [4]float64) *ErrValidation {
+	west, south, east, north := bbox[0], bbox[1], bbox[2], bbox[3]
+	in := lat >= south && lat <= north
+
+	if west <= east {
+		in = in && lng >= west && lng <= east
+	} else {
+		in = in && (lng >= west || lng <= east)
+	}
+
+	if !in {
+		args := struct {
+			BBox [4]float64
+		}{
+			bbox,
+		}
+		code := fmt.Sprintf(geoErrorCode, geoBoundingBoxErrorCode)
+		message := fmt.Sprintf(geoBoundingBoxErrorMessage, field, bbox)
+
+		return NewError(code, args, message, field, [2]float64{lng, lat})
+	}
+
+	return nil
+}
+
+// GeoInPolygon returns error if the point lng,lat is not within polygon,
+// otherwise nil. polygon is as the coordinates of a GeoJSON Polygon, ie. an
+// exterior ring followed by holes, each a list of lng,lat positions. Points
+// are tested on a plane, so polygons should not cross the antimeridian, and
+// points exactly on an edge may be either within or not.
+func GeoInPolygon(field string, lng, lat float64, polygon [][][]float64) *ErrValidation {
+	in := len(polygon) > 0 && geoInRing(lng, lat, polygon[0])
+
+	for i := 1; in && i < len(polygon); i++ {
+		in = !geoInRing(lng, lat, polygon[i])
+	}
+
+	if !in {
+		args := struct{}{}
+		code := fmt.Sprintf(geoErrorCode, geoPolygonErrorCode)
+		message := fmt.Sprintf(geoPolygonErrorMessage, field)
+
+		return NewError(code, args, message, field, [2]float64{lng, lat})
+	}
+
+	return nil
+}
+
+// StringGeoJSON returns error if value is not a GeoJSON geometry as in RFC
+// 7946, ie. a Point, MultiPoint, LineString, MultiLineString, Polygon,
+// MultiPolygon or GeometryCollection, otherwise nil. Positions must have a
+// longitude and latitude in range and an optional altitude, line strings at
+// least 2 positions, and polygon rings at least 4 positions and be closed. If
+// windingOrder is true, exterior rings must also be counterclockwise and holes
+// clockwise. The Path of the offending member, eg. coordinates[0][3], is
+// reported in Args.
+func StringGeoJSON(field, value string, windingOrder bool) *ErrValidation {
+	return geoCheckGeometry(field, value, json.RawMessage(value), "", windingOrder)
+}
+
+// geoCheckGeometry returns error if data is not a GeoJSON geometry, with the
+// paths of members prefixed with path.
+func geoCheckGeometry(field, value string, data json.RawMessage, path string, windingOrder bool) *ErrValidation {
+	var g geoJSON
+
+	if err := json.Unmarshal(data, &g); err != nil {
+		return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, path)
+	}
+
+	coordinates := path + "coordinates"
+	var err error
+
+	switch g.Type {
+	case "Point":
+		var p []float64
+
+		if err = json.Unmarshal(g.Coordinates, &p); err == nil {
+			return geoCheckPositions(field, value, [][]float64{p}, coordinates, false)
+		}
+	case "MultiPoint", "LineString":
+		var ps [][]float64
+
+		if err = json.Unmarshal(g.Coordinates, &ps); err == nil {
+			if g.Type == "LineString" && len(ps) < 2 {
+				return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, coordinates)
+			}
+
+			return geoCheckPositions(field, value, ps, coordinates, true)
+		}
+	case "MultiLineString":
+		var ls [][][]float64
+
+		if err = json.Unmarshal(g.Coordinates, &ls); err == nil {
+			for i, ps := range ls {
+				if len(ps) < 2 {
+					return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, sliceField(coordinates, i))
+				}
+
+				if err := geoCheckPositions(field, value, ps, sliceField(coordinates, i), true); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	case "Polygon":
+		var rings [][][]float64
+
+		if err = json.Unmarshal(g.Coordinates, &rings); err == nil {
+			return geoCheckPolygon(field, value, rings, coordinates, windingOrder)
+		}
+	case "MultiPolygon":
+		var polygons [][][][]float64
+
+		if err = json.Unmarshal(g.Coordinates, &polygons); err == nil {
+			for i, rings := range polygons {
+				if err := geoCheckPolygon(field, value, rings, sliceField(coordinates, i), windingOrder); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	case "GeometryCollection":
+		for i, geometry := range g.Geometries {
+			if err := geoCheckGeometry(field, value, geometry, sliceField(path+"geometries", i)+".", windingOrder); err != nil {
+				return err
+			}
+		}
+
+		if g.Geometries != nil {
+			return nil
+		}
+
+		return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, path+"geometries")
+	}
+
+	if err != nil {
+		return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, coordinates)
+	}
+
+	return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, path+"type")
+}
+
+// geoCheckPolygon returns error if rings are not the rings of a polygon.
+func geoCheckPolygon(field, value string, rings [][][]float64, path string, windingOrder bool) *ErrValidation {
+	if len(rings) == 0 {
+		return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, path)
+	}
+
+	for i, ring := range rings {
+		p := sliceField(path, i)
+
+		if len(ring) < 4 {
+			return geoJSONError(field, value, geoJSONErrorCode, geoJSONErrorMessage, p)
+		}
+
+		if err := geoCheckPositions(field, value, ring, p, true); err != nil {
+			return err
+		}
+
+		first, last := ring[0], ring[len(ring)-1]
+
+		if len(first) != len(last) || first[0] != last[0] || first[1] != last[1] {
+			return geoJSONError(field, value, geoJSONRingClosedErrorCode, geoJSONRingClosedErrorMessage, sliceField(p, len(ring)-1))
+		}
+
+		// The exterior ring is counterclockwise, ie. has a positive area,
+		// and holes are clockwise.
+		if windingOrder && (geoRingArea(ring) > 0) != (i == 0) {
+			return geoJSONError(field, value, geoJSONWindingErrorCode, geoJSONWindingErrorMessage, p)
+		}
+	}
+
+	return nil
+}
+
+// geoCheckPositions returns error if any of positions is not a position. If
+// list is true, positions are a list, and their indices are appended to path.
+func geoCheckPositions(field, value string, positions [][]float64, path string, list bool) *ErrValidation {
+	for i, p := range positions {
+		if len(p) < 2 || len(p) > 3 || p[0] < -180 || p[0] > 180 || p[1] < -90 || p[1] > 90 {
+			if list {
+				path = sliceField(path, i)
+			}
+
+			return geoJSONError(field, value, geoJSONPositionErrorCode, geoJSONPositionErrorMessage, path)
+		}
+	}
+
+	return nil
+}
+
+// geoJSONError returns error with code and message about the member at path.
+func geoJSONError(field, value, code, message, path string) *ErrValidation {
+	args := struct {
+		Path string
+	}{
+		path,
+	}
+	if code == geoJSONErrorCode {
+		message = fmt.Sprintf(message, field)
+	} else {
+		message = fmt.Sprintf(message, field, path)
+	}
+
+	code = fmt.Sprintf(geoErrorCode, code)
+
+	return NewError(code, args, message, field, value)
+}
+
+// geoRingArea returns the signed area of ring on a plane, positive if ring is
+// counterclockwise.
+func geoRingArea(ring [][]float64) float64 {
+	area := 0.0
+
+	for i := 0; i < len(ring)-1; i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+
+	return area / 2
+}
+
+// geoInRing reports whether lng,lat is within ring, by counting the edges a
+// ray cast from the point crosses.
+func geoInRing(lng, lat float64, ring [][]float64) bool {
+	in := false
+
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		if len(ring[i]) < 2 || len(ring[j]) < 2 {
+			continue
+		}
+
+		xi, yi, xj, yj := ring[i][0], ring[i][1], ring[j][0], ring[j][1]
+
+		if (yi > lat) != (yj > lat) && lng < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+
+	return in
+}
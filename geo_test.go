@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeoCoordinates(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ErrValidation
+		code string
+	}{
+		{"GeoLatitude", GeoLatitude("f", 1.3521), ""},
+		{"GeoLatitude", GeoLatitude("f", -90), ""},
+		{"GeoLatitude", GeoLatitude("f", 90.0001), "ERROR_NUMBER_BETWEEN"},
+		{"GeoLatitude", GeoLatitude("f", math.NaN()), "ERROR_NUMBER_NOT_A_NUMBER"},
+		{"GeoLongitude", GeoLongitude("f", 180), ""},
+		{"GeoLongitude", GeoLongitude("f", -180.5), "ERROR_NUMBER_BETWEEN"},
+		{"GeoPrecision", GeoPrecision("f", 103.81984, 5), ""},
+		{"GeoPrecision", GeoPrecision("f", 103.819845, 5), "ERROR_NUMBER_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.err); code != tt.code {
+			t.Errorf("%v() = %q, want %q", tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestGeoInBoundingBox(t *testing.T) {
+	singapore := [4]float64{103.6, 1.2, 104.1, 1.5}
+	fiji := [4]float64{177, -21, -178, -12}
+
+	tests := []struct {
+		lng, lat float64
+		bbox     [4]float64
+		code     string
+	}{
+		{103.8198, 1.3521, singapore, ""},
+		{103.6, 1.5, singapore, ""},
+		{101.6869, 3.139, singapore, "ERROR_GEO_BOUNDING_BOX"},
+		{179, -17, fiji, ""},
+		{-179, -17, fiji, ""},
+		{0, -17, fiji, "ERROR_GEO_BOUNDING_BOX"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(GeoInBoundingBox("f", tt.lng, tt.lat, tt.bbox)); code != tt.code {
+			t.Errorf("GeoInBoundingBox(%v, %v, %v) = %q, want %q", tt.lng, tt.lat, tt.bbox, code, tt.code)
+		}
+	}
+}
+
+func TestGeoInPolygon(t *testing.T) {
+	polygon := [][][]float64{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}},
+	}
+
+	tests := []struct {
+		lng, lat float64
+		polygon  [][][]float64
+		code     string
+	}{
+		{2, 2, polygon, ""},
+		{5, 5, polygon, "ERROR_GEO_POLYGON"},
+		{11, 5, polygon, "ERROR_GEO_POLYGON"},
+		{5, 5, polygon[:1], ""},
+		{5, 5, nil, "ERROR_GEO_POLYGON"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(GeoInPolygon("f", tt.lng, tt.lat, tt.polygon)); code != tt.code {
+			t.Errorf("GeoInPolygon(%v, %v, %v) = %q, want %q", tt.lng, tt.lat, tt.polygon, code, tt.code)
+		}
+	}
+}
+
+func TestStringGeoJSON(t *testing.T) {
+	tests := []struct {
+		value        string
+		windingOrder bool
+		code         string
+		path         string
+	}{
+		{`{"type": "Point", "coordinates": [103.8, 1.35]}`, false, "", ""},
+		{`{"type": "Point", "coordinates": [103.8, 1.35, 15]}`, false, "", ""},
+		{`{"type": "Point", "coordinates": [103.8, 91]}`, false, "ERROR_GEO_GEOJSON_POSITION", "coordinates"},
+		{`{"type": "Point", "coordinates": [103.8]}`, false, "ERROR_GEO_GEOJSON_POSITION", "coordinates"},
+		{`{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}`, false, "", ""},
+		{`{"type": "LineString", "coordinates": [[0, 0]]}`, false, "ERROR_GEO_GEOJSON", "coordinates"},
+		{`{"type": "MultiLineString", "coordinates": [[[0, 0], [1, 1]], [[0, 0], [181, 1]]]}`, false, "ERROR_GEO_GEOJSON_POSITION", "coordinates[1][1]"},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`, true, "", ""},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [0, 1], [1, 1], [0, 0]]]}`, false, "", ""},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [0, 1], [1, 1], [0, 0]]]}`, true, "ERROR_GEO_GEOJSON_WINDING_ORDER", "coordinates[0]"},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}`, false, "ERROR_GEO_GEOJSON_RING_NOT_CLOSED", "coordinates[0][3]"},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 0]]]}`, false, "ERROR_GEO_GEOJSON", "coordinates[0]"},
+		{`{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]], []]}`, false, "ERROR_GEO_GEOJSON", "coordinates[1]"},
+		{`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [0, 0]}]}`, false, "", ""},
+		{`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [0, 99]}]}`, false, "ERROR_GEO_GEOJSON_POSITION", "geometries[0].coordinates"},
+		{`{"type": "GeometryCollection"}`, false, "ERROR_GEO_GEOJSON", "geometries"},
+		{`{"type": "Feature", "coordinates": [0, 0]}`, false, "ERROR_GEO_GEOJSON", "type"},
+		{`{"type": "Point", "coordinates": "0, 0"}`, false, "ERROR_GEO_GEOJSON", "coordinates"},
+		{`not json`, false, "ERROR_GEO_GEOJSON", ""},
+	}
+
+	for _, tt := range tests {
+		err := StringGeoJSON("f", tt.value, tt.windingOrder)
+
+		if code := errCode(err); code != tt.code {
+			t.Errorf("StringGeoJSON(%v, %v) = %q, want %q", tt.value, tt.windingOrder, code, tt.code)
+
+			continue
+		}
+
+		if err != nil && err.Args.(struct{ Path string }).Path != tt.path {
+			t.Errorf("StringGeoJSON(%v, %v) Path = %q, want %q", tt.value, tt.windingOrder, err.Args.(struct{ Path string }).Path, tt.path)
+		}
+	}
+}
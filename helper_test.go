@@ -0,0 +1,10 @@
+package validation
+
+// errCode returns the code of err, or "" if err is nil.
+func errCode(err *ErrValidation) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Code
+}
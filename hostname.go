@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	strHostnameErrorCode           = "HOSTNAME"
+	strDomainErrorCode             = "DOMAIN"
+	strDomainPublicSuffixErrorCode = "DOMAIN_PUBLIC_SUFFIX"
+	strDomainWildcardErrorCode     = "DOMAIN_WILDCARD"
+)
+
+const (
+	strHostnameErrorMessage           = "%v is not a hostname"
+	strDomainErrorMessage             = "%v is not a domain name"
+	strDomainPublicSuffixErrorMessage = "%v is a public suffix"
+	strDomainWildcardErrorMessage     = "%v is not a wildcard domain name"
+)
+
+// hostIDNA converts Unicode domain names to ASCII with the rules of IDNA 2008
+// and the UTS #46 mapping used for lookup, eg. case folding.
+var hostIDNA = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.ValidateLabels(true),
+	idna.StrictDomainName(true),
+	idna.VerifyDNSLength(true),
+)
+
+// StringHostname returns error if value is not a hostname as defined by RFC
+// 1123, otherwise nil. The hostname must be at most 253 characters, excluding
+// an optional trailing dot, and each label must be 1 to 63 letters, digits and
+// hyphens, not starting or ending with a hyphen.
+func StringHostname(field, value string) *ErrValidation {
+	if label, i, ok := hostCheck(value); !ok {
+		args := struct {
+			Label string
+			Index int
+		}{
+			label, i,
+		}
+		code := fmt.Sprintf(strErrorCode, strHostnameErrorCode)
+		message := fmt.Sprintf(strHostnameErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringDomain returns error if value is not a domain name, otherwise nil.
+// Unicode domain names, eg. bücher.example, are converted to ASCII with IDNA
+// 2008 before being checked as in StringHostname.
+func StringDomain(field, value string) *ErrValidation {
+	_, err := hostDomain(field, value)
+
+	return err
+}
+
+// StringDomainNotPublicSuffix returns error if value is not a domain name, or
+// is a public suffix, eg. com or co.uk, otherwise nil. Public suffixes are
+// looked up in the list embedded in golang.org/x/net/publicsuffix.
+func StringDomainNotPublicSuffix(field, value string) *ErrValidation {
+	ascii, err := hostDomain(field, value)
+
+	if err != nil {
+		return err
+	}
+
+	return hostNotPublicSuffix(field, value, ascii)
+}
+
+// StringDomainWildcard returns error if value is not a domain name with an
+// optional wildcard as its leftmost label, eg. *.example.com, otherwise nil.
+// The domain name following the wildcard must not be a public suffix, so
+// *.com is rejected.
+func StringDomainWildcard(field, value string) *ErrValidation {
+	if !strings.HasPrefix(value, "*.") {
+		if strings.Contains(value, "*") {
+			args := struct {
+				Label string
+				Index int
+			}{
+				"*", strings.Count(value[:strings.Index(value, "*")], "."),
+			}
+			code := fmt.Sprintf(strErrorCode, strDomainWildcardErrorCode)
+			message := fmt.Sprintf(strDomainWildcardErrorMessage, field)
+
+			return NewError(code, args, message, field, value)
+		}
+
+		return StringDomain(field, value)
+	}
+
+	ascii, err := hostDomain(field, value[2:])
+
+	if err != nil {
+		return err
+	}
+
+	return hostNotPublicSuffix(field, value, ascii)
+}
+
+// hostDomain converts value to ASCII, and returns error if value is not a
+// domain name.
+func hostDomain(field, value string) (string, *ErrValidation) {
+	code := fmt.Sprintf(strErrorCode, strDomainErrorCode)
+	message := fmt.Sprintf(strDomainErrorMessage, field)
+
+	ascii, err := hostIDNA.ToASCII(strings.TrimSuffix(value, "."))
+
+	if err != nil {
+		args := struct {
+			Label string
+			Index int
+			Cause string
+		}{
+			"", -1, err.Error(),
+		}
+
+		// Convert label by label to find the one that fails.
+		for i, label := range strings.Split(strings.TrimSuffix(value, "."), ".") {
+			if _, err := hostIDNA.ToASCII(label); err != nil {
+				args.Label, args.Index, args.Cause = label, i, err.Error()
+
+				break
+			}
+		}
+
+		return "", NewError(code, args, message, field, value)
+	}
+
+	if label, i, ok := hostCheck(ascii); !ok {
+		args := struct {
+			Label string
+			Index int
+			Cause string
+		}{
+			label, i, "",
+		}
+
+		return "", NewError(code, args, message, field, value)
+	}
+
+	return ascii, nil
+}
+
+// hostNotPublicSuffix returns error if ascii, the ASCII form of value, is a
+// public suffix.
+func hostNotPublicSuffix(field, value, ascii string) *ErrValidation {
+	if suffix, _ := publicsuffix.PublicSuffix(ascii); suffix == ascii {
+		args := struct {
+			Suffix string
+		}{
+			suffix,
+		}
+		code := fmt.Sprintf(strErrorCode, strDomainPublicSuffixErrorCode)
+		message := fmt.Sprintf(strDomainPublicSuffixErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// hostCheck checks s against the rules of RFC 1123. If s fails, hostCheck
+// returns the failing label and its index, or an index of -1 if s is too long.
+func hostCheck(s string) (string, int, bool) {
+	s = strings.TrimSuffix(s, ".")
+
+	if s == "" || len(s) > 253 {
+		return "", -1, false
+	}
+
+	for i, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return label, i, false
+		}
+
+		for j := 0; j < len(label); j++ {
+			c := label[j]
+
+			if c != '-' && (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+				return label, i, false
+			}
+		}
+	}
+
+	return "", 0, true
+}
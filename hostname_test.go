@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStringHostname(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"example.com", ""},
+		{"example.com.", ""},
+		{"a-1.b2.example", ""},
+		{"localhost", ""},
+		{"1.2.3.4", ""},
+		{strings.Repeat("a", 63) + ".com", ""},
+		{strings.Repeat("a", 64) + ".com", "ERROR_STRING_HOSTNAME"},
+		{strings.Repeat("a.", 127) + "a", "ERROR_STRING_HOSTNAME"},
+		{"-example.com", "ERROR_STRING_HOSTNAME"},
+		{"example-.com", "ERROR_STRING_HOSTNAME"},
+		{"exa_mple.com", "ERROR_STRING_HOSTNAME"},
+		{"example..com", "ERROR_STRING_HOSTNAME"},
+		{"bücher.example", "ERROR_STRING_HOSTNAME"},
+		{"", "ERROR_STRING_HOSTNAME"},
+		{".", "ERROR_STRING_HOSTNAME"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringHostname("f", tt.value)); code != tt.code {
+			t.Errorf("StringHostname(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringDomain(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"example.com", ""},
+		{"bücher.example", ""},
+		{"xn--bcher-kva.example", ""},
+		{"EXAMPLE.com.", ""},
+		{"exa mple.com", "ERROR_STRING_DOMAIN"},
+		{"-example.com", "ERROR_STRING_DOMAIN"},
+		{"example..com", "ERROR_STRING_DOMAIN"},
+		{"", "ERROR_STRING_DOMAIN"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringDomain("f", tt.value)); code != tt.code {
+			t.Errorf("StringDomain(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringDomainPublicSuffix(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(field, value string) *ErrValidation
+		value string
+		code  string
+	}{
+		{"StringDomainNotPublicSuffix", StringDomainNotPublicSuffix, "example.com", ""},
+		{"StringDomainNotPublicSuffix", StringDomainNotPublicSuffix, "example.co.uk", ""},
+		{"StringDomainNotPublicSuffix", StringDomainNotPublicSuffix, "com", "ERROR_STRING_DOMAIN_PUBLIC_SUFFIX"},
+		{"StringDomainNotPublicSuffix", StringDomainNotPublicSuffix, "co.uk", "ERROR_STRING_DOMAIN_PUBLIC_SUFFIX"},
+		{"StringDomainNotPublicSuffix", StringDomainNotPublicSuffix, "-x.com", "ERROR_STRING_DOMAIN"},
+		{"StringDomainWildcard", StringDomainWildcard, "*.example.com", ""},
+		{"StringDomainWildcard", StringDomainWildcard, "example.com", ""},
+		{"StringDomainWildcard", StringDomainWildcard, "*.com", "ERROR_STRING_DOMAIN_PUBLIC_SUFFIX"},
+		{"StringDomainWildcard", StringDomainWildcard, "*.co.uk", "ERROR_STRING_DOMAIN_PUBLIC_SUFFIX"},
+		{"StringDomainWildcard", StringDomainWildcard, "a.*.example.com", "ERROR_STRING_DOMAIN_WILDCARD"},
+		{"StringDomainWildcard", StringDomainWildcard, "*example.com", "ERROR_STRING_DOMAIN_WILDCARD"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.fn("f", tt.value)); code != tt.code {
+			t.Errorf("%v(%q) = %q, want %q", tt.name, tt.value, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	strUUIDErrorCode        = "UUID"
+	strUUIDVersionErrorCode = "UUID_VERSION"
+	strUUIDNilErrorCode     = "UUID_NIL"
+	strULIDErrorCode        = "ULID"
+	strKSUIDErrorCode       = "KSUID"
+)
+
+const (
+	strUUIDErrorMessage        = "%v is not a UUID"
+	strUUIDVersionErrorMessage = "%v is not a UUID of version %v"
+	strUUIDNilErrorMessage     = "%v is the nil UUID"
+	strULIDErrorMessage        = "%v is not a ULID"
+	strKSUIDErrorMessage       = "%v is not a KSUID"
+)
+
+const (
+	idCrockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	idBase62Alphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	idKSUIDMax          = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+// StringUUID returns error if value is not a UUID in the canonical form, eg.
+// 6ba7b810-9dad-11d1-80b4-00c04fd430c8, otherwise nil. Hexadecimal digits are
+// matched case-insensitively. If versions is not empty, the version of value
+// must be one of versions. The nil UUID is accepted only if allowNil is true.
+func StringUUID(field, value string, versions []int, allowNil bool) *ErrValidation {
+	return idUUID(field, value, value, versions, allowNil)
+}
+
+// StringUUIDAnyForm is the same as StringUUID, but also accepts the UUID
+// enclosed in braces, eg. {6ba7b810-9dad-11d1-80b4-00c04fd430c8}, or as a
+// URN, eg. urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8.
+func StringUUIDAnyForm(field, value string, versions []int, allowNil bool) *ErrValidation {
+	s := value
+
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	} else if len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	}
+
+	return idUUID(field, value, s, versions, allowNil)
+}
+
+// StringULID returns error if value is not a ULID, ie. 26 characters of
+// Crockford's base32 not exceeding 7ZZZZZZZZZZZZZZZZZZZZZZZZZ, otherwise nil.
+// Characters are matched case-insensitively.
+func StringULID(field, value string) *ErrValidation {
+	code := fmt.Sprintf(strErrorCode, strULIDErrorCode)
+	message := fmt.Sprintf(strULIDErrorMessage, field)
+
+	if len(value) != 26 || value[0] > '7' {
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	for i, c := range strings.ToUpper(value) {
+		if !strings.ContainsRune(idCrockfordAlphabet, c) {
+			args := struct {
+				Char  string
+				Index int
+			}{
+				string(c), i,
+			}
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringKSUID returns error if value is not a KSUID, ie. 27 characters of
+// base62 not exceeding aWgEPTl1tmebfsQzFP4bxwgy80V, otherwise nil.
+func StringKSUID(field, value string) *ErrValidation {
+	code := fmt.Sprintf(strErrorCode, strKSUIDErrorCode)
+	message := fmt.Sprintf(strKSUIDErrorMessage, field)
+
+	if len(value) != 27 {
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	for i, c := range value {
+		if !strings.ContainsRune(idBase62Alphabet, c) {
+			args := struct {
+				Char  string
+				Index int
+			}{
+				string(c), i,
+			}
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	// Base62 digits sort in the same order as their values, so a string
+	// comparison is enough to detect overflow of the 160-bit payload.
+	if value > idKSUIDMax {
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	return nil
+}
+
+// idUUID validates s, the canonical form of the UUID extracted from value.
+func idUUID(field, value, s string, versions []int, allowNil bool) *ErrValidation {
+	code := fmt.Sprintf(strErrorCode, strUUIDErrorCode)
+	message := fmt.Sprintf(strUUIDErrorMessage, field)
+
+	if len(s) != 36 {
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	var b [16]byte
+	j := 0
+
+	for i := 0; i < 36; i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if s[i] != '-' {
+				return NewError(code, struct{}{}, message, field, value)
+			}
+
+			continue
+		}
+
+		n := idHex(s[i])
+
+		if n < 0 {
+			return NewError(code, struct{}{}, message, field, value)
+		}
+
+		b[j/2] |= byte(n) << (4 * (1 - j%2))
+		j++
+	}
+
+	if b == [16]byte{} {
+		if allowNil {
+			return nil
+		}
+
+		code = fmt.Sprintf(strErrorCode, strUUIDNilErrorCode)
+		message = fmt.Sprintf(strUUIDNilErrorMessage, field)
+
+		return NewError(code, struct{}{}, message, field, value)
+	}
+
+	args := struct {
+		Version int
+		Variant string
+	}{
+		int(b[6] >> 4), idUUIDVariant(b[8]),
+	}
+
+	valid := args.Variant == "RFC9562" && args.Version >= 1 && args.Version <= 8
+
+	if valid && len(versions) > 0 {
+		valid = false
+
+		for _, v := range versions {
+			if v == args.Version {
+				valid = true
+
+				break
+			}
+		}
+	}
+
+	if !valid {
+		code = fmt.Sprintf(strErrorCode, strUUIDVersionErrorCode)
+		message = fmt.Sprintf(strUUIDVersionErrorMessage, field, versions)
+
+		if len(versions) == 0 {
+			message = fmt.Sprintf(strUUIDVersionErrorMessage, field, "1 to 8")
+		}
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// idUUIDVariant returns the name of the variant encoded in octet 8 of a UUID.
+func idUUIDVariant(b byte) string {
+	switch {
+	case b&0x80 == 0:
+		return "NCS"
+	case b&0xc0 == 0x80:
+		return "RFC9562"
+	case b&0xe0 == 0xc0:
+		return "Microsoft"
+	default:
+		return "Future"
+	}
+}
+
+// idHex returns the value of the hexadecimal digit c, or -1 if c is not a
+// hexadecimal digit.
+func idHex(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'F':
+		return int(c-'A') + 10
+	}
+
+	return -1
+}
@@ -0,0 +1,93 @@
+package validation
+
+import "testing"
+
+func TestStringUUID(t *testing.T) {
+	tests := []struct {
+		value    string
+		versions []int
+		allowNil bool
+		code     string
+	}{
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", nil, false, ""},
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", []int{1}, false, ""},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", []int{4, 7}, false, ""},
+		{"018f6b1e-7c3a-7d2e-9f10-2b3c4d5e6f70", []int{7}, false, ""},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", []int{4}, false, "ERROR_STRING_UUID_VERSION"},
+		{"6ba7b810-9dad-01d1-80b4-00c04fd430c8", nil, false, "ERROR_STRING_UUID_VERSION"},
+		{"6ba7b810-9dad-11d1-c0b4-00c04fd430c8", nil, false, "ERROR_STRING_UUID_VERSION"},
+		{"00000000-0000-0000-0000-000000000000", nil, true, ""},
+		{"00000000-0000-0000-0000-000000000000", nil, false, "ERROR_STRING_UUID_NIL"},
+		{"6ba7b8109dad11d180b400c04fd430c8", nil, false, "ERROR_STRING_UUID"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cg", nil, false, "ERROR_STRING_UUID"},
+		{"6ba7b810_9dad-11d1-80b4-00c04fd430c8", nil, false, "ERROR_STRING_UUID"},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", nil, false, "ERROR_STRING_UUID"},
+		{"", nil, false, "ERROR_STRING_UUID"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringUUID("f", tt.value, tt.versions, tt.allowNil)); code != tt.code {
+			t.Errorf("StringUUID(%q, %v, %v) = %q, want %q", tt.value, tt.versions, tt.allowNil, code, tt.code)
+		}
+	}
+}
+
+func TestStringUUIDAnyForm(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", ""},
+		{"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8", "ERROR_STRING_UUID"},
+		{"urn:6ba7b810-9dad-11d1-80b4-00c04fd430c8", "ERROR_STRING_UUID"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringUUIDAnyForm("f", tt.value, nil, false)); code != tt.code {
+			t.Errorf("StringUUIDAnyForm(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringULID(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", ""},
+		{"01arz3ndektsv4rrffq69g5fav", ""},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", ""},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", "ERROR_STRING_ULID"},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAI", "ERROR_STRING_ULID"},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FA", "ERROR_STRING_ULID"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringULID("f", tt.value)); code != tt.code {
+			t.Errorf("StringULID(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringKSUID(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", ""},
+		{"000000000000000000000000000", ""},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", ""},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80W", "ERROR_STRING_KSUID"},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO-", "ERROR_STRING_KSUID"},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO", "ERROR_STRING_KSUID"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringKSUID("f", tt.value)); code != tt.code {
+			t.Errorf("StringKSUID(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
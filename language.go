@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	strLanguageTagErrorCode = "LANGUAGE_TAG"
+)
+
+const (
+	strLanguageTagErrorMessage = "%v is not a BCP 47 language tag"
+)
+
+// StringLanguageTag returns error if value is not a BCP 47 language tag,
+// otherwise nil. See LanguageTag.
+func StringLanguageTag(field, value string) *ErrValidation {
+	_, err := LanguageTag(field, value)
+
+	return err
+}
+
+// LanguageTag returns value as a BCP 47 language tag in canonical form, eg.
+// zh-Hant-TW for ZH-hant-tw or tlh for i-klingon, or error if value is not a
+// well-formed language tag with subtags known to the IANA registry. Subtags
+// must be separated by hyphens. The invalid Subtag, if any, is reported in
+// Args.
+func LanguageTag(field, value string) (string, *ErrValidation) {
+	tag, err := language.Parse(value)
+
+	if err != nil || strings.Contains(value, "_") {
+		subtag := ""
+
+		if e, ok := err.(language.ValueError); ok {
+			subtag = e.Subtag()
+		}
+
+		args := struct {
+			Subtag string
+		}{
+			subtag,
+		}
+		code := fmt.Sprintf(strErrorCode, strLanguageTagErrorCode)
+		message := fmt.Sprintf(strLanguageTagErrorMessage, field)
+
+		return "", NewError(code, args, message, field, value)
+	}
+
+	return tag.String(), nil
+}
@@ -0,0 +1,33 @@
+package validation
+
+import "testing"
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		code  string
+	}{
+		{"en", "en", ""},
+		{"en-US", "en-US", ""},
+		{"ZH-hant-tw", "zh-Hant-TW", ""},
+		{"sr-Latn-RS", "sr-Latn-RS", ""},
+		{"de-CH-1996", "de-CH-1996", ""},
+		{"en_US", "", "ERROR_STRING_LANGUAGE_TAG"},
+		{"en-", "", "ERROR_STRING_LANGUAGE_TAG"},
+		{"xx-YY-zzzzzzzzz", "", "ERROR_STRING_LANGUAGE_TAG"},
+		{"", "", "ERROR_STRING_LANGUAGE_TAG"},
+	}
+
+	for _, tt := range tests {
+		got, err := LanguageTag("f", tt.value)
+
+		if code := errCode(err); got != tt.want || code != tt.code {
+			t.Errorf("LanguageTag(%q) = %q, %q, want %q, %q", tt.value, got, code, tt.want, tt.code)
+		}
+
+		if code := errCode(StringLanguageTag("f", tt.value)); code != tt.code {
+			t.Errorf("StringLanguageTag(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+const (
+	mapErrorCode = "ERROR_MAP_%v"
+)
+
+const (
+	mapLenMinErrorCode       = "LENGTH_MIN"
+	mapLenMaxErrorCode       = "LENGTH_MAX"
+	mapRequiredKeyErrorCode  = "REQUIRED_KEY"
+	mapForbiddenKeyErrorCode = "FORBIDDEN_KEY"
+	mapAllowedKeyErrorCode   = "ALLOWED_KEY"
+)
+
+const (
+	mapLenMinErrorMessage       = "%v has fewer than %v entries"
+	mapLenMaxErrorMessage       = "%v has more than %v entries"
+	mapRequiredKeyErrorMessage  = "%v is missing required key %v"
+	mapForbiddenKeyErrorMessage = "%v contains forbidden key %v"
+	mapAllowedKeyErrorMessage   = "%v contains key %v with no match in %v"
+)
+
+// MapLenMin returns error if values has fewer than min entries, otherwise nil.
+// MapLenMin panics if values is not a map.
+func MapLenMin(field string, values interface{}, min int) *ErrValidation {
+	l := mapValue(values).Len()
+
+	if l < min {
+		args := struct {
+			Min, Len int
+		}{
+			min, l,
+		}
+		code := fmt.Sprintf(mapErrorCode, mapLenMinErrorCode)
+		message := fmt.Sprintf(mapLenMinErrorMessage, field, min)
+
+		return NewError(code, args, message, field, nil)
+	}
+
+	return nil
+}
+
+// MapLenMax returns error if values has more than max entries, otherwise nil.
+// MapLenMax panics if values is not a map.
+func MapLenMax(field string, values interface{}, max int) *ErrValidation {
+	l := mapValue(values).Len()
+
+	if l > max {
+		args := struct {
+			Max, Len int
+		}{
+			max, l,
+		}
+		code := fmt.Sprintf(mapErrorCode, mapLenMaxErrorCode)
+		message := fmt.Sprintf(mapLenMaxErrorMessage, field, max)
+
+		return NewError(code, args, message, field, nil)
+	}
+
+	return nil
+}
+
+// MapRequiredKeys returns error if any of keys is not found in values,
+// otherwise nil. MapRequiredKeys panics if values is not a map with string
+// keys.
+func MapRequiredKeys(field string, values interface{}, keys []string) *ErrValidation {
+	m := mapKeySet(values)
+
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			args := struct {
+				Key string
+			}{
+				k,
+			}
+			code := fmt.Sprintf(mapErrorCode, mapRequiredKeyErrorCode)
+			message := fmt.Sprintf(mapRequiredKeyErrorMessage, field, k)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// MapForbiddenKeys returns error if any of keys is found in values, otherwise
+// nil. MapForbiddenKeys panics if values is not a map with string keys.
+func MapForbiddenKeys(field string, values interface{}, keys []string) *ErrValidation {
+	m := mapKeySet(values)
+
+	for _, k := range keys {
+		if _, ok := m[k]; ok {
+			args := struct {
+				Key string
+			}{
+				k,
+			}
+			code := fmt.Sprintf(mapErrorCode, mapForbiddenKeyErrorCode)
+			message := fmt.Sprintf(mapForbiddenKeyErrorMessage, field, k)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// MapAllowedKeys returns error if any key of values has no match in allowed,
+// otherwise nil. Keys are checked in sorted order. MapAllowedKeys panics if
+// values is not a map with string keys.
+func MapAllowedKeys(field string, values interface{}, allowed []string) *ErrValidation {
+	m := make(map[string]struct{})
+
+	for _, k := range allowed {
+		m[k] = struct{}{}
+	}
+
+	for _, k := range mapKeys(values) {
+		if _, ok := m[k]; !ok {
+			args := struct {
+				Key string
+			}{
+				k,
+			}
+			code := fmt.Sprintf(mapErrorCode, mapAllowedKeyErrorCode)
+			message := fmt.Sprintf(mapAllowedKeyErrorMessage, field, k, allowed)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// MapEachKey calls fn for every key of values in sorted order, with the key
+// appended to field, eg. metadata[region]. MapEachKey returns the first error
+// returned by fn, otherwise nil. MapEachKey panics if values is not a map with
+// string keys.
+func MapEachKey(field string, values interface{}, fn func(field, key string) *ErrValidation) *ErrValidation {
+	for _, k := range mapKeys(values) {
+		if err := fn(mapField(field, k), k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MapEachValue calls fn for every value of values in sorted order of keys,
+// with the key appended to field, eg. metadata[region]. MapEachValue returns
+// the first error returned by fn, otherwise nil. MapEachValue panics if values
+// is not a map with string keys.
+func MapEachValue(field string, values interface{}, fn func(field string, value interface{}) *ErrValidation) *ErrValidation {
+	v := mapValue(values)
+
+	for _, k := range mapKeys(values) {
+		if err := fn(mapField(field, k), v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MapValueByKey calls the function in rules keyed by the same key for every
+// value of values in sorted order of keys, with the key appended to field, eg.
+// attributes[weight]. Keys without a rule are skipped. MapValueByKey returns
+// the first error returned, otherwise nil. MapValueByKey panics if values is
+// not a map with string keys.
+func MapValueByKey(field string, values interface{}, rules map[string]func(field string, value interface{}) *ErrValidation) *ErrValidation {
+	v := mapValue(values)
+
+	for _, k := range mapKeys(values) {
+		fn, ok := rules[k]
+
+		if !ok {
+			continue
+		}
+
+		if err := fn(mapField(field, k), v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapValue returns the reflect.Value of values, and panics if values is not a
+// map.
+func mapValue(values interface{}) reflect.Value {
+	v := reflect.ValueOf(values)
+
+	if v.Kind() != reflect.Map {
+		panic("values must be a map")
+	}
+
+	return v
+}
+
+// mapKeys returns the keys of values in sorted order, and panics if values is
+// not a map with string keys.
+func mapKeys(values interface{}) []string {
+	v := mapValue(values)
+
+	if v.Type().Key().Kind() != reflect.String {
+		panic("values must be a map with string keys")
+	}
+
+	keys := make([]string, 0, v.Len())
+
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// mapKeySet returns the keys of values as a set, and panics if values is not a
+// map with string keys.
+func mapKeySet(values interface{}) map[string]struct{} {
+	m := make(map[string]struct{})
+
+	for _, k := range mapKeys(values) {
+		m[k] = struct{}{}
+	}
+
+	return m
+}
+
+// mapField returns the field path of the entry keyed by key in field.
+func mapField(field, key string) string {
+	return fmt.Sprintf("%v[%v]", field, key)
+}
@@ -0,0 +1,69 @@
+package validation
+
+import "testing"
+
+func TestMapKeys(t *testing.T) {
+	values := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	tests := []struct {
+		name string
+		err  *ErrValidation
+		code string
+	}{
+		{"MapLenMin", MapLenMin("f", values, 3), ""},
+		{"MapLenMin", MapLenMin("f", values, 4), "ERROR_MAP_LENGTH_MIN"},
+		{"MapLenMax", MapLenMax("f", values, 3), ""},
+		{"MapLenMax", MapLenMax("f", values, 2), "ERROR_MAP_LENGTH_MAX"},
+		{"MapRequiredKeys", MapRequiredKeys("f", values, []string{"a", "c"}), ""},
+		{"MapRequiredKeys", MapRequiredKeys("f", values, []string{"a", "d"}), "ERROR_MAP_REQUIRED_KEY"},
+		{"MapForbiddenKeys", MapForbiddenKeys("f", values, []string{"d"}), ""},
+		{"MapForbiddenKeys", MapForbiddenKeys("f", values, []string{"d", "b"}), "ERROR_MAP_FORBIDDEN_KEY"},
+		{"MapAllowedKeys", MapAllowedKeys("f", values, []string{"a", "b", "c", "d"}), ""},
+		{"MapAllowedKeys", MapAllowedKeys("f", values, []string{"a", "b"}), "ERROR_MAP_ALLOWED_KEY"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.err); code != tt.code {
+			t.Errorf("%v() = %q, want %q", tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestMapEach(t *testing.T) {
+	values := map[string]string{"b": "", "a": "x", "c": ""}
+
+	if err := MapEachKey("m", values, StringNotEmpty); err != nil {
+		t.Errorf("MapEachKey() = %v, want nil", err)
+	}
+
+	err := MapEachValue("m", values, func(field string, value interface{}) *ErrValidation {
+		return StringNotEmpty(field, value.(string))
+	})
+
+	if err == nil || err.Field != "m[b]" {
+		t.Errorf("MapEachValue() = %v, want error on m[b]", err)
+	}
+
+	rules := map[string]func(field string, value interface{}) *ErrValidation{
+		"a": func(field string, value interface{}) *ErrValidation {
+			return StringNotEmpty(field, value.(string))
+		},
+		"c": func(field string, value interface{}) *ErrValidation {
+			return StringNotEmpty(field, value.(string))
+		},
+	}
+
+	if err := MapValueByKey("m", values, rules); err == nil || err.Field != "m[c]" {
+		t.Errorf("MapValueByKey() = %v, want error on m[c]", err)
+	}
+}
+
+func TestMapKeysPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MapRequiredKeys(map[int]int) did not panic")
+		}
+	}()
+
+	MapRequiredKeys("f", map[int]int{1: 1}, []string{"1"})
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"fmt"
+	"mime"
+	"regexp"
+	"strings"
+)
+
+const (
+	strMediaTypeErrorCode = "MEDIA_TYPE"
+)
+
+const (
+	strMediaTypeErrorMessage = "%v is not a media type"
+)
+
+// mediaTypeTopLevels are the registered top-level media types.
+var mediaTypeTopLevels = map[string]struct{}{
+	"application": {}, "audio": {}, "example": {}, "font": {}, "haptics": {}, "image": {},
+	"message": {}, "model": {}, "multipart": {}, "text": {}, "video": {},
+}
+
+// mediaTypeNamePattern is the restricted-name of RFC 6838, in lowercase.
+var mediaTypeNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9!#$&^_.+-]{0,126}$`)
+
+// StringMediaType returns error if value is not a media type as in RFC 6838,
+// or a media range if ranges is true, otherwise nil. See MediaType.
+func StringMediaType(field, value string, ranges bool) *ErrValidation {
+	_, err := MediaType(field, value, ranges)
+
+	return err
+}
+
+// MediaType returns value as a media type with the type, subtype and parameter
+// names in lowercase and the parameters sorted, eg. text/html; charset=UTF-8,
+// or error if value is not a media type as in RFC 6838, ie. a registered
+// top-level type and a subtype, optionally followed by parameters. If ranges
+// is true, media ranges as in HTTP Accept headers, eg. */* and image/*, are
+// also accepted. The failing Component, one of type, subtype or parameter, is
+// reported in Args.
+func MediaType(field, value string, ranges bool) (string, *ErrValidation) {
+	t, params, err := mime.ParseMediaType(value)
+	component := ""
+
+	if err != nil {
+		component = "parameter"
+
+		if t == "" {
+			component = "type"
+		}
+	}
+
+	top, sub, ok := strings.Cut(t, "/")
+
+	switch {
+	case component != "":
+	case !ok:
+		component = "type"
+	case ranges && top == "*" && sub == "*":
+	case ranges && sub == "*":
+		if _, ok := mediaTypeTopLevels[top]; !ok {
+			component = "type"
+		}
+	default:
+		if _, ok := mediaTypeTopLevels[top]; !ok {
+			component = "type"
+		} else if !mediaTypeNamePattern.MatchString(sub) {
+			component = "subtype"
+		}
+	}
+
+	if component != "" {
+		args := struct {
+			Component string
+		}{
+			component,
+		}
+		code := fmt.Sprintf(strErrorCode, strMediaTypeErrorCode)
+		message := fmt.Sprintf(strMediaTypeErrorMessage, field)
+
+		return "", NewError(code, args, message, field, value)
+	}
+
+	return mime.FormatMediaType(t, params), nil
+}
@@ -0,0 +1,37 @@
+package validation
+
+import "testing"
+
+func TestMediaType(t *testing.T) {
+	tests := []struct {
+		value  string
+		ranges bool
+		want   string
+		code   string
+	}{
+		{"text/html", false, "text/html", ""},
+		{"Text/HTML; Charset=UTF-8", false, "text/html; charset=UTF-8", ""},
+		{"application/vnd.api+json", false, "application/vnd.api+json", ""},
+		{"image/svg+xml; b=2; a=1", false, "image/svg+xml; a=1; b=2", ""},
+		{"*/*", true, "*/*", ""},
+		{"image/*", true, "image/*", ""},
+		{"image/*", false, "", "ERROR_STRING_MEDIA_TYPE"},
+		{"foo/*", true, "", "ERROR_STRING_MEDIA_TYPE"},
+		{"foo/bar", false, "", "ERROR_STRING_MEDIA_TYPE"},
+		{"text", false, "", "ERROR_STRING_MEDIA_TYPE"},
+		{"text/html; charset", false, "", "ERROR_STRING_MEDIA_TYPE"},
+		{"", false, "", "ERROR_STRING_MEDIA_TYPE"},
+	}
+
+	for _, tt := range tests {
+		got, err := MediaType("f", tt.value, tt.ranges)
+
+		if code := errCode(err); got != tt.want || code != tt.code {
+			t.Errorf("MediaType(%q, %v) = %q, %q, want %q, %q", tt.value, tt.ranges, got, code, tt.want, tt.code)
+		}
+
+		if code := errCode(StringMediaType("f", tt.value, tt.ranges)); code != tt.code {
+			t.Errorf("StringMediaType(%q, %v) = %q, want %q", tt.value, tt.ranges, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package validation
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	strNationalIDErrorCode = "NATIONAL_ID"
+)
+
+const (
+	strNationalIDErrorMessage = "%v is not a national identity number of %v"
+)
+
+var nationalIDRules = &countryRules{rules: map[string]CountryRule{
+	"ID": nationalIDID,
+	"MY": nationalIDMY,
+	"SG": nationalIDSG,
+}}
+
+// nationalIDMYInvalidPlaces are the unassigned birthplace codes of MyKad
+// numbers.
+var nationalIDMYInvalidPlaces = map[int]struct{}{
+	0: {}, 17: {}, 18: {}, 19: {}, 20: {}, 69: {}, 70: {}, 73: {}, 80: {}, 81: {}, 94: {}, 95: {}, 96: {}, 97: {},
+}
+
+// RegisterNationalIDRule sets the rule used by StringNationalID to check
+// national identity numbers of country, an ISO 3166-1 alpha-2 code, replacing
+// any existing rule. RegisterNationalIDRule is safe for concurrent use.
+func RegisterNationalIDRule(country string, rule CountryRule) {
+	nationalIDRules.set(country, rule)
+}
+
+// StringNationalID returns error if value is not a national identity number of
+// country, an ISO 3166-1 alpha-2 code, otherwise nil. Spaces and hyphens are
+// ignored. Rules are built in for ID (NIK), MY (MyKad) and SG (NRIC and FIN),
+// and can be added with RegisterNationalIDRule. Countries without a rule are
+// rejected.
+func StringNationalID(field, value, country string) *ErrValidation {
+	return countryCheck(field, value, country, nationalIDRules, strNationalIDErrorCode, strNationalIDErrorMessage)
+}
+
+// nationalIDMY checks a MyKad number of the form YYMMDD-PB-###G, where YYMMDD
+// is the birth date and PB is the birthplace code.
+func nationalIDMY(value string) string {
+	if len(value) != 12 || !strDigits(value) {
+		return countryComponentFormat
+	}
+
+	if !nationalIDDate(value[:2], value[2:4], value[4:6]) {
+		return countryComponentBirthDate
+	}
+
+	pb, _ := strconv.Atoi(value[6:8])
+
+	if _, ok := nationalIDMYInvalidPlaces[pb]; ok {
+		return countryComponentRegion
+	}
+
+	return ""
+}
+
+// nationalIDSG checks an NRIC or FIN number of the form @0000000#, where @ is
+// S, T, F, G or M, and # is the check letter.
+func nationalIDSG(value string) string {
+	if len(value) != 9 || !strDigits(value[1:8]) || value[8] < 'A' || value[8] > 'Z' {
+		return countryComponentFormat
+	}
+
+	sum := 0
+
+	for i, w := range []int{2, 7, 6, 5, 4, 3, 2} {
+		sum += int(value[i+1]-'0') * w
+	}
+
+	var letters string
+
+	switch value[0] {
+	case 'S':
+		letters = "JZIHGFEDCBA"
+	case 'T':
+		letters, sum = "JZIHGFEDCBA", sum+4
+	case 'F':
+		letters = "XWUTRQPNMLK"
+	case 'G':
+		letters, sum = "XWUTRQPNMLK", sum+4
+	case 'M':
+		// The M-series table, KLJNPQRTUWX, is indexed from the end.
+		letters, sum = "XWUTRQPNJLK", sum+3
+	default:
+		return countryComponentFormat
+	}
+
+	if letters[sum%11] != value[8] {
+		return countryComponentChecksum
+	}
+
+	return ""
+}
+
+// nationalIDID checks a NIK number of the form PPKKCCDDMMYYSSSS, where PP is
+// the province code and DDMMYY is the birth date, with 40 added to DD for
+// women.
+func nationalIDID(value string) string {
+	if len(value) != 16 || !strDigits(value) {
+		return countryComponentFormat
+	}
+
+	if pp, _ := strconv.Atoi(value[:2]); pp < 11 || pp > 94 {
+		return countryComponentRegion
+	}
+
+	dd, _ := strconv.Atoi(value[6:8])
+
+	if dd > 40 {
+		dd -= 40
+	}
+
+	if !nationalIDDate(value[10:12], value[8:10], strconv.Itoa(dd)) {
+		return countryComponentBirthDate
+	}
+
+	return ""
+}
+
+// nationalIDDate reports whether yy, mm and dd form a date in either the 20th
+// or the 21st century.
+func nationalIDDate(yy, mm, dd string) bool {
+	y, _ := strconv.Atoi(yy)
+	m, _ := strconv.Atoi(mm)
+	d, _ := strconv.Atoi(dd)
+
+	if m < 1 || m > 12 || d < 1 {
+		return false
+	}
+
+	for _, century := range []int{1900, 2000} {
+		t := time.Date(century+y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+
+		if t.Day() == d {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package validation
+
+import "testing"
+
+// countryComponent returns the Component arg of err, or "" if err is nil.
+func countryComponent(err *ErrValidation) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Args.(struct {
+		Country   string
+		Component string
+	}).Component
+}
+
+func TestStringNationalID(t *testing.T) {
+	tests := []struct {
+		value, country string
+		component      string
+	}{
+		// NRIC and FIN numbers with check letters computed by the ICA algorithm.
+		{"S0000001I", "SG", ""},
+		{"S1234567D", "SG", ""},
+		{"s1234567d", "SG", ""},
+		{"T1234567J", "SG", ""},
+		{"F1234567N", "SG", ""},
+		{"G1234567X", "SG", ""},
+		// M-series FIN numbers, with check letters computed by the ICA
+		// algorithm, ie. KLJNPQRTUWX indexed by 10-(sum+3)%11.
+		{"M1234567K", "SG", ""},
+		{"M0000001Q", "SG", ""},
+		{"M7654321J", "SG", ""},
+		{"M7654321W", "SG", "checksum"},
+		{"S1234567A", "SG", "checksum"},
+		{"T1234567D", "SG", "checksum"},
+		{"F1234567J", "SG", "checksum"},
+		{"G1234567N", "SG", "checksum"},
+		{"M1234567X", "SG", "checksum"},
+		{"A1234567D", "SG", "format"},
+		{"S123456D", "SG", "format"},
+		{"S12345678", "SG", "format"},
+		{"880101-14-5678", "MY", ""},
+		{"880229 14 5678", "MY", ""},
+		{"000229145678", "MY", ""},
+		{"890229145678", "MY", "birth_date"},
+		{"881301145678", "MY", "birth_date"},
+		{"880230145678", "MY", "birth_date"},
+		{"880101005678", "MY", "region"},
+		{"880101175678", "MY", "region"},
+		{"88010114567", "MY", "format"},
+		{"88010114567A", "MY", "format"},
+		{"3174010101900001", "ID", ""},
+		{"3174014101900001", "ID", ""},
+		{"9974010101900001", "ID", "region"},
+		{"1074010101900001", "ID", "region"},
+		{"3174013201900001", "ID", "birth_date"},
+		{"3174017201900001", "ID", "birth_date"},
+		{"3174010113900001", "ID", "birth_date"},
+		{"317401010190000", "ID", "format"},
+		{"S1234567D", "ZZ", "country"},
+	}
+
+	for _, tt := range tests {
+		if component := countryComponent(StringNationalID("f", tt.value, tt.country)); component != tt.component {
+			t.Errorf("StringNationalID(%q, %q) component = %q, want %q", tt.value, tt.country, component, tt.component)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package validation
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+)
+
+const (
+	strIPErrorCode        = "IP"
+	strIPVersionErrorCode = "IP_VERSION"
+	strCIDRErrorCode      = "CIDR"
+	strIPPrefixErrorCode  = "IP_PREFIX"
+	strIPTypeErrorCode    = "IP_TYPE"
+	strPortErrorCode      = "PORT"
+	strHostPortErrorCode  = "HOST_PORT"
+)
+
+const (
+	strIPErrorMessage        = "%v is not an IP address"
+	strIPVersionErrorMessage = "%v is not an IPv%v address"
+	strCIDRErrorMessage      = "%v is not a CIDR prefix"
+	strIPPrefixErrorMessage  = "%v is not within %v"
+	strIPTypeErrorMessage    = "%v is a %v address"
+	strPortErrorMessage      = "%v is not a port number"
+	strHostPortErrorMessage  = "%v is not in the form of host:port"
+)
+
+// IPType is a class of IP addresses, as classified for StringIPNotType and
+// StringIPPublic.
+type IPType string
+
+// IP address types reported in the Type arg, and accepted by StringIPNotType.
+const (
+	IPTypeUnspecified   IPType = "unspecified"
+	IPTypeLoopback      IPType = "loopback"
+	IPTypePrivate       IPType = "private"
+	IPTypeLinkLocal     IPType = "link-local"
+	IPTypeMulticast     IPType = "multicast"
+	IPTypeBroadcast     IPType = "broadcast"
+	IPTypeShared        IPType = "shared"
+	IPTypeDocumentation IPType = "documentation"
+	IPTypeReserved      IPType = "reserved"
+	IPTypeGlobal        IPType = "global"
+)
+
+// ipNonPublicTypes are the address types rejected by StringIPPublic.
+var ipNonPublicTypes = []IPType{
+	IPTypeUnspecified,
+	IPTypeLoopback,
+	IPTypePrivate,
+	IPTypeLinkLocal,
+	IPTypeMulticast,
+	IPTypeBroadcast,
+	IPTypeShared,
+	IPTypeDocumentation,
+	IPTypeReserved,
+}
+
+// ipSpecialPrefixes are the special-purpose address blocks of the IANA IPv4 and
+// IPv6 registries not covered by the methods of netip.Addr, in the order they
+// are checked.
+var ipSpecialPrefixes = []struct {
+	t        IPType
+	prefixes []netip.Prefix
+}{
+	{IPTypeBroadcast, []netip.Prefix{
+		netip.MustParsePrefix("255.255.255.255/32"),
+	}},
+	{IPTypeShared, []netip.Prefix{
+		netip.MustParsePrefix("100.64.0.0/10"),
+	}},
+	{IPTypeDocumentation, []netip.Prefix{
+		netip.MustParsePrefix("192.0.2.0/24"),
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("203.0.113.0/24"),
+		netip.MustParsePrefix("2001:db8::/32"),
+		netip.MustParsePrefix("3fff::/20"),
+	}},
+	{IPTypeReserved, []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("192.0.0.0/24"),
+		netip.MustParsePrefix("198.18.0.0/15"),
+		netip.MustParsePrefix("240.0.0.0/4"),
+		netip.MustParsePrefix("100::/64"),
+		netip.MustParsePrefix("2001:2::/48"),
+	}},
+}
+
+// ipNAT64Prefix is the well-known prefix of RFC 6052, whose addresses embed an
+// IPv4 address in their last 32 bits.
+var ipNAT64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+// StringIP returns error if value is not an IP address of version, otherwise
+// nil. version is 4, 6, or 0 for either. IPv6 addresses with a zone, eg.
+// fe80::1%eth0, are rejected. StringIP panics if version is not 0, 4 or 6.
+func StringIP(field, value string, version int) *ErrValidation {
+	_, err := ipParse(field, value, version)
+
+	return err
+}
+
+// StringCIDR returns error if value is not a CIDR prefix of version, eg.
+// 10.0.0.0/8, otherwise nil. version is 4, 6, or 0 for either. StringCIDR
+// panics if version is not 0, 4 or 6.
+func StringCIDR(field, value string, version int) *ErrValidation {
+	ipCheckVersion(version)
+
+	p, err := netip.ParsePrefix(value)
+
+	if err != nil || (version == 4 && !p.Addr().Is4()) || (version == 6 && !p.Addr().Is6()) {
+		args := struct {
+			Version int
+		}{
+			version,
+		}
+		code := fmt.Sprintf(strErrorCode, strCIDRErrorCode)
+		message := fmt.Sprintf(strCIDRErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringIPInPrefixes returns error if value is not an IP address within any of
+// prefixes, eg. 10.0.0.0/8, otherwise nil. StringIPInPrefixes panics if any of
+// prefixes is not a CIDR prefix.
+func StringIPInPrefixes(field, value string, prefixes []string) *ErrValidation {
+	ps := make([]netip.Prefix, 0, len(prefixes))
+
+	for _, s := range prefixes {
+		p, err := netip.ParsePrefix(s)
+
+		if err != nil {
+			panic("prefixes must be CIDR prefixes")
+		}
+
+		ps = append(ps, p)
+	}
+
+	addr, err := ipParse(field, value, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ps {
+		if p.Contains(addr) || p.Contains(addr.Unmap()) {
+			return nil
+		}
+	}
+
+	args := struct {
+		Type IPType
+	}{
+		ipType(addr),
+	}
+	code := fmt.Sprintf(strErrorCode, strIPPrefixErrorCode)
+	message := fmt.Sprintf(strIPPrefixErrorMessage, field, prefixes)
+
+	return NewError(code, args, message, field, value)
+}
+
+// StringIPNotType returns error if value is not an IP address, or is an IP
+// address of any of types, eg. IPTypeLoopback, otherwise nil.
+func StringIPNotType(field, value string, types []IPType) *ErrValidation {
+	addr, err := ipParse(field, value, 0)
+
+	if err != nil {
+		return err
+	}
+
+	t := ipType(addr)
+
+	for _, v := range types {
+		if v == t {
+			args := struct {
+				Type IPType
+			}{
+				t,
+			}
+			code := fmt.Sprintf(strErrorCode, strIPTypeErrorCode)
+			message := fmt.Sprintf(strIPTypeErrorMessage, field, t)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringIPPublic returns error if value is not an IP address, or is not a
+// global IP address, ie. is an unspecified, loopback, private, link-local,
+// multicast, broadcast, shared (CGNAT), documentation or reserved IP address,
+// otherwise nil. NAT64 addresses are classified by the IPv4 address they
+// embed, eg. 64:ff9b::7f00:1 is a loopback address.
+func StringIPPublic(field, value string) *ErrValidation {
+	return StringIPNotType(field, value, ipNonPublicTypes)
+}
+
+// StringPort returns error if value is not a port number between 1 and 65535,
+// otherwise nil.
+func StringPort(field, value string) *ErrValidation {
+	if _, ok := ipPort(value); !ok {
+		args := struct{}{}
+		code := fmt.Sprintf(strErrorCode, strPortErrorCode)
+		message := fmt.Sprintf(strPortErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringHostPort returns error if value is not in the form of host:port, eg.
+// example.com:443 or [::1]:8080, otherwise nil. host must be an IP address or
+// a hostname, and port must be a port number between 1 and 65535.
+func StringHostPort(field, value string) *ErrValidation {
+	host, port, err := net.SplitHostPort(value)
+
+	args := struct {
+		Host string
+		Port int
+	}{
+		host, 0,
+	}
+	code := fmt.Sprintf(strErrorCode, strHostPortErrorCode)
+	message := fmt.Sprintf(strHostPortErrorMessage, field)
+
+	if err != nil {
+		return NewError(code, args, message, field, value)
+	}
+
+	p, ok := ipPort(port)
+
+	if !ok {
+		return NewError(code, args, message, field, value)
+	}
+
+	args.Port = p
+
+	if addr, err := netip.ParseAddr(host); err == nil && addr.Zone() == "" {
+		return nil
+	}
+
+	if _, _, ok := hostCheck(host); !ok {
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// ipParse parses value as an IP address of version, and returns error if
+// value cannot be parsed.
+func ipParse(field, value string, version int) (netip.Addr, *ErrValidation) {
+	ipCheckVersion(version)
+
+	addr, err := netip.ParseAddr(value)
+
+	if err != nil || addr.Zone() != "" {
+		args := struct {
+			Version int
+		}{
+			version,
+		}
+		code := fmt.Sprintf(strErrorCode, strIPErrorCode)
+		message := fmt.Sprintf(strIPErrorMessage, field)
+
+		return addr, NewError(code, args, message, field, value)
+	}
+
+	if (version == 4 && !addr.Is4()) || (version == 6 && !addr.Is6()) {
+		args := struct {
+			Version int
+			Type    IPType
+		}{
+			version, ipType(addr),
+		}
+		code := fmt.Sprintf(strErrorCode, strIPVersionErrorCode)
+		message := fmt.Sprintf(strIPVersionErrorMessage, field, version)
+
+		return addr, NewError(code, args, message, field, value)
+	}
+
+	return addr, nil
+}
+
+// ipCheckVersion panics if version is not 0, 4 or 6.
+func ipCheckVersion(version int) {
+	if version != 0 && version != 4 && version != 6 {
+		panic("version must be 0, 4 or 6")
+	}
+}
+
+// ipType classifies addr. IPv4-mapped IPv6 addresses are classified as IPv4
+// addresses, and NAT64 addresses as the IPv4 addresses they embed.
+func ipType(addr netip.Addr) IPType {
+	addr = addr.Unmap()
+
+	if ipNAT64Prefix.Contains(addr) {
+		b := addr.As16()
+		addr = netip.AddrFrom4([4]byte{b[12], b[13], b[14], b[15]})
+	}
+
+	switch {
+	case addr.IsUnspecified():
+		return IPTypeUnspecified
+	case addr.IsLoopback():
+		return IPTypeLoopback
+	case addr.IsMulticast():
+		return IPTypeMulticast
+	case addr.IsLinkLocalUnicast():
+		return IPTypeLinkLocal
+	case addr.IsPrivate():
+		return IPTypePrivate
+	}
+
+	for _, s := range ipSpecialPrefixes {
+		for _, p := range s.prefixes {
+			if p.Contains(addr) {
+				return s.t
+			}
+		}
+	}
+
+	return IPTypeGlobal
+}
+
+// ipPort parses s as a port number between 1 and 65535.
+func ipPort(s string) (int, bool) {
+	p, err := strconv.ParseUint(s, 10, 16)
+
+	if err != nil || p == 0 {
+		return 0, false
+	}
+
+	return int(p), true
+}
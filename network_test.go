@@ -0,0 +1,80 @@
+package validation
+
+import "testing"
+
+func TestStringIPPublic(t *testing.T) {
+	tests := []struct {
+		value string
+		t     IPType
+	}{
+		{"8.8.8.8", IPTypeGlobal},
+		{"2606:4700:4700::1111", IPTypeGlobal},
+		{"0.0.0.0", IPTypeUnspecified},
+		{"0.1.2.3", IPTypeReserved},
+		{"127.0.0.1", IPTypeLoopback},
+		{"10.1.2.3", IPTypePrivate},
+		{"169.254.1.1", IPTypeLinkLocal},
+		{"224.0.0.1", IPTypeMulticast},
+		{"255.255.255.255", IPTypeBroadcast},
+		{"100.64.0.1", IPTypeShared},
+		{"100.127.255.255", IPTypeShared},
+		{"100.128.0.1", IPTypeGlobal},
+		{"192.0.2.1", IPTypeDocumentation},
+		{"198.51.100.1", IPTypeDocumentation},
+		{"203.0.113.1", IPTypeDocumentation},
+		{"198.18.0.1", IPTypeReserved},
+		{"198.19.255.255", IPTypeReserved},
+		{"240.0.0.1", IPTypeReserved},
+		{"2001:db8::1", IPTypeDocumentation},
+		{"::ffff:192.0.2.1", IPTypeDocumentation},
+		{"64:ff9b::7f00:1", IPTypeLoopback},
+		{"64:ff9b::808:808", IPTypeGlobal},
+		{"fc00::1", IPTypePrivate},
+		{"fe80::1", IPTypeLinkLocal},
+	}
+
+	for _, tt := range tests {
+		err := StringIPPublic("f", tt.value)
+
+		if tt.t == IPTypeGlobal {
+			if err != nil {
+				t.Errorf("StringIPPublic(%q) = %v, want nil", tt.value, err)
+			}
+
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("StringIPPublic(%q) = nil, want type %v", tt.value, tt.t)
+
+			continue
+		}
+
+		if args, ok := err.Args.(struct{ Type IPType }); !ok || args.Type != tt.t {
+			t.Errorf("StringIPPublic(%q) = %v, want type %v", tt.value, err, tt.t)
+		}
+	}
+}
+
+func TestStringHostPort(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"example.com:443", true},
+		{"[::1]:8080", true},
+		{"127.0.0.1:80", true},
+		{"-bad-.com:80", false},
+		{"bad-.com:80", false},
+		{"a..com:80", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.com:80", false},
+		{"example.com:0", false},
+		{"example.com", false},
+	}
+
+	for _, tt := range tests {
+		if err := StringHostPort("f", tt.value); (err == nil) != tt.valid {
+			t.Errorf("StringHostPort(%q) = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
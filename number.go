@@ -3,6 +3,8 @@ package validation
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -20,6 +22,12 @@ const (
 	numBetweenErrorCode     = "BETWEEN"
 	numFormatErrorCode      = "FORMAT"
 	numNoDecimalErrorCode   = "NO_DECIMAL"
+	numNoDuplicateErrorCode = "NO_DUPLICATE"
+	numSubsetOfErrorCode    = "SUBSET_OF"
+	numContainsAllErrorCode = "CONTAINS_ALL"
+	numSortedErrorCode      = "SORTED"
+	numMultipleOfErrorCode  = "MULTIPLE_OF"
+	numStepErrorCode        = "STEP"
 )
 
 const (
@@ -31,6 +39,12 @@ const (
 	numBetweenErrorMessage       = "%v is not between %v and %v"
 	numFormatErrorMessage        = "%v does not conform with the format %v"
 	numNoDecimalErrorMessage     = "%v has unexpected decimal places"
+	numNoDuplicateErrorMessage   = "%v has duplicated values"
+	numSubsetOfErrorMessage      = "%v has value(s) with no match in %v"
+	numContainsAllErrorMessage   = "%v does not contain all of %v"
+	numSortedErrorMessage        = "%v is not sorted in ascending order"
+	numMultipleOfErrorMessage    = "%v is not a multiple of %v"
+	numStepErrorMessage          = "%v is not in steps of %v from %v"
 )
 
 // NumberNotANumber returns error if value is NaN, otherwise nil.
@@ -1323,3 +1337,300 @@ func NumberFormat(field string, value float64, format string) *ErrValidation {
 
 	return nil
 }
+
+// NumberNoDuplicate returns error if values contain duplicated value,
+// otherwise nil. NumberNoDuplicate panics if values is not a slice of numbers.
+func NumberNoDuplicate(field string, values interface{}) *ErrValidation {
+	v := numberSliceValue(values)
+	m := make(map[interface{}]struct{})
+
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i).Interface()
+
+		if _, ok := m[e]; ok {
+			args := struct {
+				Index int
+				Found interface{}
+			}{
+				i, e,
+			}
+			code := fmt.Sprintf(numErrorCode, numNoDuplicateErrorCode)
+			message := fmt.Sprintf(numNoDuplicateErrorMessage, field)
+
+			return NewError(code, args, message, field, nil)
+		}
+
+		m[e] = struct{}{}
+	}
+
+	return nil
+}
+
+// NumberSubsetOf returns error if any of values has no match in allowed,
+// otherwise nil. NumberSubsetOf panics if values and allowed are not slices of
+// the same number type.
+func NumberSubsetOf(field string, values, allowed interface{}) *ErrValidation {
+	v1 := numberSliceValue(values)
+	v2 := numberSliceValue(allowed)
+
+	if v1.Type().Elem() != v2.Type().Elem() {
+		panic("values and allowed must have the same type")
+	}
+
+	m := make(map[interface{}]struct{})
+
+	for i := 0; i < v2.Len(); i++ {
+		m[v2.Index(i).Interface()] = struct{}{}
+	}
+
+	for i := 0; i < v1.Len(); i++ {
+		e := v1.Index(i).Interface()
+
+		if _, ok := m[e]; !ok {
+			args := struct {
+				Index int
+				Found interface{}
+			}{
+				i, e,
+			}
+			code := fmt.Sprintf(numErrorCode, numSubsetOfErrorCode)
+			message := fmt.Sprintf(numSubsetOfErrorMessage, field, allowed)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// NumberContainsAll returns error if any of required has no match in values,
+// otherwise nil. NumberContainsAll panics if values and required are not
+// slices of the same number type.
+func NumberContainsAll(field string, values, required interface{}) *ErrValidation {
+	v1 := numberSliceValue(values)
+	v2 := numberSliceValue(required)
+
+	if v1.Type().Elem() != v2.Type().Elem() {
+		panic("values and required must have the same type")
+	}
+
+	m := make(map[interface{}]struct{})
+
+	for i := 0; i < v1.Len(); i++ {
+		m[v1.Index(i).Interface()] = struct{}{}
+	}
+
+	for i := 0; i < v2.Len(); i++ {
+		e := v2.Index(i).Interface()
+
+		if _, ok := m[e]; !ok {
+			args := struct {
+				Missing interface{}
+			}{
+				e,
+			}
+			code := fmt.Sprintf(numErrorCode, numContainsAllErrorCode)
+			message := fmt.Sprintf(numContainsAllErrorMessage, field, required)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// NumberSorted returns error if values is not sorted in ascending order,
+// otherwise nil. NumberSorted panics if values is not a slice of numbers.
+func NumberSorted(field string, values interface{}) *ErrValidation {
+	v := numberSliceValue(values)
+
+	for i := 1; i < v.Len(); i++ {
+		if numberLess(v.Index(i), v.Index(i-1)) {
+			args := struct {
+				Index int
+				Found interface{}
+			}{
+				i, v.Index(i).Interface(),
+			}
+			code := fmt.Sprintf(numErrorCode, numSortedErrorCode)
+			message := fmt.Sprintf(numSortedErrorMessage, field)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// NumberMultipleOf returns error if value is not a multiple of step, otherwise
+// nil. It is NumberStep with a base of 0. NumberMultipleOf panics if value and
+// step have different types, or if step is not greater than 0.
+func NumberMultipleOf(field string, value, step interface{}) *ErrValidation {
+	v := numberValue(value)
+	code := fmt.Sprintf(numErrorCode, numMultipleOfErrorCode)
+	message := fmt.Sprintf(numMultipleOfErrorMessage, field, step)
+
+	return numberStep(field, v, reflect.Zero(v.Type()), numberValue(step), code, message)
+}
+
+// NumberStep returns error if value is not base plus a multiple of step, as
+// the step attribute of an HTML input with a min of base, otherwise nil. The
+// valid values nearest to value, Below and Above, are reported in Args, or nil
+// if out of the range of the type of value. Floats are compared by their
+// shortest decimal representation, so 0.3 is base 0 plus a multiple of 0.1,
+// and NaN and infinite values are never valid. NumberStep panics if value,
+// base and step have different types, if base is not finite, or if step is not
+// greater than 0.
+func NumberStep(field string, value, base, step interface{}) *ErrValidation {
+	code := fmt.Sprintf(numErrorCode, numStepErrorCode)
+	message := fmt.Sprintf(numStepErrorMessage, field, step, base)
+
+	return numberStep(field, numberValue(value), numberValue(base), numberValue(step), code, message)
+}
+
+// numberStep returns error with code and message if value is not base plus a
+// multiple of step.
+func numberStep(field string, value, base, step reflect.Value, code, message string) *ErrValidation {
+	if value.Type() != base.Type() || value.Type() != step.Type() {
+		panic("value, base and step must have the same type")
+	}
+
+	b, ok := numberRat(base)
+
+	if !ok {
+		panic("base must be finite")
+	}
+
+	s, ok := numberRat(step)
+
+	if !ok || s.Sign() <= 0 {
+		panic("step must be greater than 0")
+	}
+
+	args := struct {
+		Base, Step   interface{}
+		Below, Above interface{}
+	}{
+		base.Interface(), step.Interface(), nil, nil,
+	}
+
+	v, ok := numberRat(value)
+
+	if ok {
+		// n is the number of whole steps from base to value, rounded down.
+		q := new(big.Rat).Quo(new(big.Rat).Sub(v, b), s)
+
+		if q.IsInt() {
+			return nil
+		}
+
+		n := new(big.Int).Div(q.Num(), q.Denom())
+		below := new(big.Rat).Add(b, new(big.Rat).Mul(new(big.Rat).SetInt(n), s))
+		above := new(big.Rat).Add(below, s)
+		args.Below = numberFromRat(below, value.Type())
+		args.Above = numberFromRat(above, value.Type())
+	}
+
+	return NewError(code, args, message, field, value.Interface())
+}
+
+// numberValue returns the reflect.Value of value, and panics if value is not
+// a number.
+func numberValue(value interface{}) reflect.Value {
+	v := reflect.ValueOf(value)
+
+	if !numberKind(v.Kind()) {
+		panic("value must be a number")
+	}
+
+	return v
+}
+
+// numberRat returns v as an exact rational, floats by their shortest decimal
+// representation, and false if v is NaN or infinite.
+func numberRat(v reflect.Value) (*big.Rat, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetUint64(v.Uint()), true
+	}
+
+	if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+}
+
+// numberFromRat returns r as a number of type t, or nil if r is out of its
+// range.
+func numberFromRat(r *big.Rat, t reflect.Type) interface{} {
+	v := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !r.Num().IsInt64() || v.OverflowInt(r.Num().Int64()) {
+			return nil
+		}
+
+		v.SetInt(r.Num().Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !r.Num().IsUint64() || v.OverflowUint(r.Num().Uint64()) {
+			return nil
+		}
+
+		v.SetUint(r.Num().Uint64())
+	default:
+		f, _ := r.Float64()
+
+		if t.Kind() == reflect.Float32 {
+			f32, _ := r.Float32()
+			f = float64(f32)
+		}
+
+		if math.IsInf(f, 0) {
+			return nil
+		}
+
+		v.SetFloat(f)
+	}
+
+	return v.Interface()
+}
+
+// numberSliceValue returns the reflect.Value of values, and panics if values
+// is not a slice or an array of numbers.
+func numberSliceValue(values interface{}) reflect.Value {
+	v := reflect.ValueOf(values)
+
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || !numberKind(v.Type().Elem().Kind()) {
+		panic("values must be a slice of numbers")
+	}
+
+	return v
+}
+
+// numberKind reports whether k is the kind of an integer or a float.
+func numberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// numberLess reports whether a<b, where a and b are numbers of the same kind.
+func numberLess(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	default:
+		return a.Float() < b.Float()
+	}
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberStep(t *testing.T) {
+	tests := []struct {
+		value, base, step interface{}
+		code              string
+		below, above      interface{}
+	}{
+		{7, 1, 3, "", nil, nil},
+		{8, 1, 3, "ERROR_NUMBER_STEP", 7, 10},
+		{-5, 1, 3, "", nil, nil},
+		{-4, 1, 3, "ERROR_NUMBER_STEP", -5, -2},
+		{-1, -10, 3, "", nil, nil},
+		{-2, -10, 3, "ERROR_NUMBER_STEP", -4, -1},
+		{0.3, 0.0, 0.1, "", nil, nil},
+		{1.35, 0.1, 0.25, "", nil, nil},
+		{1.4, 0.1, 0.25, "ERROR_NUMBER_STEP", 1.35, 1.6},
+		{-0.7, 0.0, 0.2, "ERROR_NUMBER_STEP", -0.8, -0.6},
+		{-0.6, 0.0, 0.2, "", nil, nil},
+		{float32(0.3), float32(0), float32(0.1), "", nil, nil},
+		{float32(0.35), float32(0), float32(0.1), "ERROR_NUMBER_STEP", float32(0.3), float32(0.4)},
+		{math.NaN(), 0.0, 0.1, "ERROR_NUMBER_STEP", nil, nil},
+		{math.Inf(1), 0.0, 0.1, "ERROR_NUMBER_STEP", nil, nil},
+		{uint8(254), uint8(0), uint8(100), "ERROR_NUMBER_STEP", uint8(200), nil},
+		{int8(-127), int8(0), int8(100), "ERROR_NUMBER_STEP", nil, int8(-100)},
+	}
+
+	for _, tt := range tests {
+		err := NumberStep("f", tt.value, tt.base, tt.step)
+
+		if code := errCode(err); code != tt.code {
+			t.Errorf("NumberStep(%v, %v, %v) = %q, want %q", tt.value, tt.base, tt.step, code, tt.code)
+
+			continue
+		}
+
+		if err == nil {
+			continue
+		}
+
+		args := err.Args.(struct {
+			Base, Step   interface{}
+			Below, Above interface{}
+		})
+
+		if args.Below != tt.below || args.Above != tt.above {
+			t.Errorf("NumberStep(%v, %v, %v) has Below %v and Above %v, want %v and %v", tt.value, tt.base, tt.step, args.Below, args.Above, tt.below, tt.above)
+		}
+	}
+}
+
+func TestNumberMultipleOf(t *testing.T) {
+	tests := []struct {
+		value, step interface{}
+		code        string
+	}{
+		{12, 4, ""},
+		{-12, 4, ""},
+		{0, 4, ""},
+		{13, 4, "ERROR_NUMBER_MULTIPLE_OF"},
+		{-13, 4, "ERROR_NUMBER_MULTIPLE_OF"},
+		{uint64(math.MaxUint64), uint64(5), ""},
+		{0.6, 0.2, ""},
+		{-0.6, 0.2, ""},
+		{0.5, 0.2, "ERROR_NUMBER_MULTIPLE_OF"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(NumberMultipleOf("f", tt.value, tt.step)); code != tt.code {
+			t.Errorf("NumberMultipleOf(%v, %v) = %q, want %q", tt.value, tt.step, code, tt.code)
+		}
+	}
+}
+
+func TestNumberStepPanics(t *testing.T) {
+	tests := []struct {
+		name              string
+		value, base, step interface{}
+	}{
+		{"different types", 1, int64(0), 1},
+		{"zero step", 1, 0, 0},
+		{"negative step", 1, 0, -1},
+		{"NaN step", 1.0, 0.0, math.NaN()},
+		{"infinite base", 1.0, math.Inf(-1), 0.5},
+		{"not a number", "1", "0", "1"},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NumberStep(%v) did not panic", tt.name)
+				}
+			}()
+
+			NumberStep("f", tt.value, tt.base, tt.step)
+		}()
+	}
+}
@@ -0,0 +1,376 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	strPasswordLenMinErrorCode     = "PASSWORD_LENGTH_MIN"
+	strPasswordLenMaxErrorCode     = "PASSWORD_LENGTH_MAX"
+	strPasswordUpperErrorCode      = "PASSWORD_UPPER"
+	strPasswordLowerErrorCode      = "PASSWORD_LOWER"
+	strPasswordDigitErrorCode      = "PASSWORD_DIGIT"
+	strPasswordSymbolErrorCode     = "PASSWORD_SYMBOL"
+	strPasswordRepeatErrorCode     = "PASSWORD_REPEAT"
+	strPasswordSequenceErrorCode   = "PASSWORD_SEQUENCE"
+	strPasswordBannedWordErrorCode = "PASSWORD_BANNED_WORD"
+	strPasswordSimilarErrorCode    = "PASSWORD_SIMILAR"
+	strPasswordStrengthErrorCode   = "PASSWORD_STRENGTH"
+)
+
+const (
+	strPasswordLenMinErrorMessage     = "length of %v is smaller than %v"
+	strPasswordLenMaxErrorMessage     = "length of %v is greater than %v"
+	strPasswordUpperErrorMessage      = "%v has no uppercase letter"
+	strPasswordLowerErrorMessage      = "%v has no lowercase letter"
+	strPasswordDigitErrorMessage      = "%v has no digit"
+	strPasswordSymbolErrorMessage     = "%v has no symbol"
+	strPasswordRepeatErrorMessage     = "%v has a character repeated more than %v times"
+	strPasswordSequenceErrorMessage   = "%v has a sequence of more than %v characters"
+	strPasswordBannedWordErrorMessage = "%v contains a banned word"
+	strPasswordSimilarErrorMessage    = "%v is too similar to the %v"
+	strPasswordStrengthErrorMessage   = "%v is too weak"
+)
+
+// PasswordSequences are common keyboard and alphabetical sequences, usable as
+// PasswordPolicy.Sequences.
+var PasswordSequences = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"0123456789",
+	"qwertyuiop",
+	"asdfghjkl",
+	"zxcvbnm",
+}
+
+// passwordCommonWords are frequently used passwords and words in passwords,
+// which PasswordStrength credits with little entropy.
+var passwordCommonWords = []string{
+	"password", "passw0rd", "qwerty", "letmein", "welcome", "admin", "login",
+	"iloveyou", "monkey", "dragon", "football", "baseball", "sunshine",
+	"princess", "master", "shadow", "trustno1", "superman", "batman",
+	"starwars", "whatever", "freedom", "hello", "secret", "abc123",
+	"123456", "111111", "000000",
+}
+
+// passwordLeet maps common character substitutions to the letters they stand
+// for.
+var passwordLeet = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s", "!", "i",
+)
+
+// PasswordPolicy is the set of rules checked by StringPassword. Zero values
+// disable the rules.
+type PasswordPolicy struct {
+	// MinLen and MaxLen are the minimum and maximum length in runes.
+	MinLen, MaxLen int
+
+	// RequireUpper, RequireLower, RequireDigit and RequireSymbol require at
+	// least one character of the class. Symbols are any characters other than
+	// letters, digits and spaces.
+	RequireUpper, RequireLower, RequireDigit, RequireSymbol bool
+
+	// MaxRepeat is the maximum number of times a character may be repeated
+	// consecutively, eg. aaa is repeated 3 times.
+	MaxRepeat int
+
+	// Sequences and MaxSequenceLen reject passwords containing more than
+	// MaxSequenceLen consecutive characters of any of Sequences, forwards or
+	// backwards, case-insensitively, eg. abcd or 4321. See PasswordSequences.
+	Sequences      []string
+	MaxSequenceLen int
+
+	// BannedWords are rejected anywhere in the password, case-insensitively
+	// and with common substitutions such as 0 for o undone.
+	BannedWords []string
+
+	// Username and Email reject passwords containing them, or the local part
+	// of Email, case-insensitively. Parts shorter than 3 characters are
+	// ignored.
+	Username, Email string
+
+	// MinStrength is the minimum score returned by PasswordStrength.
+	MinStrength int
+}
+
+// StringPassword returns an error for each rule of policy value violates, or
+// nil if value satisfies policy. value is never included in the errors.
+func StringPassword(field, value string, policy PasswordPolicy) []*ErrValidation {
+	var errs []*ErrValidation
+
+	add := func(code, message string, args interface{}) {
+		errs = append(errs, NewError(fmt.Sprintf(strErrorCode, code), args, message, field, nil))
+	}
+
+	l := utf8.RuneCountInString(value)
+
+	if policy.MinLen > 0 && l < policy.MinLen {
+		add(strPasswordLenMinErrorCode, fmt.Sprintf(strPasswordLenMinErrorMessage, field, policy.MinLen), struct {
+			Min int
+		}{
+			policy.MinLen,
+		})
+	}
+
+	if policy.MaxLen > 0 && l > policy.MaxLen {
+		add(strPasswordLenMaxErrorCode, fmt.Sprintf(strPasswordLenMaxErrorMessage, field, policy.MaxLen), struct {
+			Max int
+		}{
+			policy.MaxLen,
+		})
+	}
+
+	var upper, lower, digit, symbol bool
+
+	for _, c := range value {
+		switch {
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsDigit(c):
+			digit = true
+		case !unicode.IsLetter(c) && !unicode.IsSpace(c):
+			symbol = true
+		}
+	}
+
+	if policy.RequireUpper && !upper {
+		add(strPasswordUpperErrorCode, fmt.Sprintf(strPasswordUpperErrorMessage, field), struct{}{})
+	}
+
+	if policy.RequireLower && !lower {
+		add(strPasswordLowerErrorCode, fmt.Sprintf(strPasswordLowerErrorMessage, field), struct{}{})
+	}
+
+	if policy.RequireDigit && !digit {
+		add(strPasswordDigitErrorCode, fmt.Sprintf(strPasswordDigitErrorMessage, field), struct{}{})
+	}
+
+	if policy.RequireSymbol && !symbol {
+		add(strPasswordSymbolErrorCode, fmt.Sprintf(strPasswordSymbolErrorMessage, field), struct{}{})
+	}
+
+	if policy.MaxRepeat > 0 && passwordMaxRepeat(value) > policy.MaxRepeat {
+		add(strPasswordRepeatErrorCode, fmt.Sprintf(strPasswordRepeatErrorMessage, field, policy.MaxRepeat), struct {
+			MaxRepeat int
+		}{
+			policy.MaxRepeat,
+		})
+	}
+
+	if policy.MaxSequenceLen > 0 && passwordHasSequence(value, policy.Sequences, policy.MaxSequenceLen+1) {
+		add(strPasswordSequenceErrorCode, fmt.Sprintf(strPasswordSequenceErrorMessage, field, policy.MaxSequenceLen), struct {
+			MaxSequenceLen int
+		}{
+			policy.MaxSequenceLen,
+		})
+	}
+
+	normalized := passwordLeet.Replace(strings.ToLower(value))
+
+	for _, w := range policy.BannedWords {
+		w = strings.ToLower(w)
+
+		if w != "" && (strings.Contains(strings.ToLower(value), w) || strings.Contains(normalized, passwordLeet.Replace(w))) {
+			add(strPasswordBannedWordErrorCode, fmt.Sprintf(strPasswordBannedWordErrorMessage, field), struct{}{})
+
+			break
+		}
+	}
+
+	local := policy.Email
+
+	if i := strings.LastIndex(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+
+	for _, s := range []struct {
+		name  string
+		parts []string
+	}{
+		{"username", []string{policy.Username}},
+		{"email", []string{policy.Email, local}},
+	} {
+		for _, p := range s.parts {
+			if len(p) >= 3 && strings.Contains(strings.ToLower(value), strings.ToLower(p)) {
+				add(strPasswordSimilarErrorCode, fmt.Sprintf(strPasswordSimilarErrorMessage, field, s.name), struct {
+					Similar string
+				}{
+					s.name,
+				})
+
+				break
+			}
+		}
+	}
+
+	if policy.MinStrength > 0 {
+		if score := PasswordStrength(value); score < policy.MinStrength {
+			add(strPasswordStrengthErrorCode, fmt.Sprintf(strPasswordStrengthErrorMessage, field), struct {
+				Score, MinStrength int
+			}{
+				score, policy.MinStrength,
+			})
+		}
+	}
+
+	return errs
+}
+
+// PasswordStrength estimates the strength of value as a score from 0, too
+// guessable, to 4, very unguessable, similar to zxcvbn. The estimate is based
+// on the entropy of the character classes used, with little credit given to
+// repeated characters, sequences and common passwords.
+func PasswordStrength(value string) int {
+	bits := PasswordEntropy(value)
+
+	switch {
+	case bits < 28:
+		return 0
+	case bits < 36:
+		return 1
+	case bits < 60:
+		return 2
+	case bits < 80:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// PasswordEntropy estimates the entropy of value in bits. See
+// PasswordStrength.
+func PasswordEntropy(value string) float64 {
+	rs := []rune(value)
+
+	if len(rs) == 0 {
+		return 0
+	}
+
+	pool := 0
+	var lower, upper, digit, symbol, other bool
+
+	for _, c := range rs {
+		switch {
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= '0' && c <= '9':
+			digit = true
+		case c < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	for _, c := range []struct {
+		used bool
+		size int
+	}{
+		{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100},
+	} {
+		if c.used {
+			pool += c.size
+		}
+	}
+
+	// Characters within common words are credited as a whole with the
+	// entropy of picking a word from the list.
+	covered := make([]bool, len(rs))
+	normalized := []rune(passwordLeet.Replace(strings.ToLower(value)))
+	words := 0
+
+	if len(normalized) == len(rs) {
+		s := string(normalized)
+
+		for _, w := range passwordCommonWords {
+			for i := strings.Index(s, w); i >= 0; i = strings.Index(s, w) {
+				start := utf8.RuneCountInString(s[:i])
+
+				for j := start; j < start+utf8.RuneCountInString(w); j++ {
+					covered[j] = true
+				}
+
+				s = s[:i] + strings.Repeat("\x00", len(w)) + s[i+len(w):]
+				words++
+			}
+		}
+	}
+
+	bits := float64(words) * math.Log2(float64(len(passwordCommonWords)))
+	perChar := math.Log2(float64(pool))
+
+	for i, c := range rs {
+		switch {
+		case covered[i]:
+		case i > 0 && (c == rs[i-1] || c == rs[i-1]+1 || c == rs[i-1]-1):
+			bits++
+		default:
+			bits += perChar
+		}
+	}
+
+	return bits
+}
+
+// passwordMaxRepeat returns the greatest number of times a character is
+// repeated consecutively in value.
+func passwordMaxRepeat(value string) int {
+	max, n := 0, 0
+	var prev rune
+
+	for i, c := range value {
+		if i > 0 && c == prev {
+			n++
+		} else {
+			n = 1
+		}
+
+		if n > max {
+			max = n
+		}
+
+		prev = c
+	}
+
+	return max
+}
+
+// passwordHasSequence reports whether value contains n consecutive characters
+// of any of sequences, forwards or backwards, case-insensitively.
+func passwordHasSequence(value string, sequences []string, n int) bool {
+	v := strings.ToLower(value)
+
+	for _, seq := range sequences {
+		seq = strings.ToLower(seq)
+
+		for _, s := range []string{seq, passwordReverse(seq)} {
+			rs := []rune(s)
+
+			for i := 0; i+n <= len(rs); i++ {
+				if strings.Contains(v, string(rs[i:i+n])) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// passwordReverse returns s with its runes in reverse order.
+func passwordReverse(s string) string {
+	rs := []rune(s)
+
+	for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+		rs[i], rs[j] = rs[j], rs[i]
+	}
+
+	return string(rs)
+}
@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringPassword(t *testing.T) {
+	classes := PasswordPolicy{RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name   string
+		value  string
+		policy PasswordPolicy
+		codes  []string
+	}{
+		{"empty policy", "", PasswordPolicy{}, nil},
+		{"MinLen", "abcdefgh", PasswordPolicy{MinLen: 8}, nil},
+		{"MinLen", "abcdefg", PasswordPolicy{MinLen: 8}, []string{"ERROR_STRING_PASSWORD_LENGTH_MIN"}},
+		{"MinLen runes", "pässwörd", PasswordPolicy{MinLen: 8}, nil},
+		{"MaxLen", "abcdefgh", PasswordPolicy{MaxLen: 8}, nil},
+		{"MaxLen", "abcdefghi", PasswordPolicy{MaxLen: 8}, []string{"ERROR_STRING_PASSWORD_LENGTH_MAX"}},
+		{"classes", "Aa1!", classes, nil},
+		{"classes", "Ää1 -", classes, nil},
+		{"classes upper", "aa1!", classes, []string{"ERROR_STRING_PASSWORD_UPPER"}},
+		{"classes lower", "AA1!", classes, []string{"ERROR_STRING_PASSWORD_LOWER"}},
+		{"classes digit", "Aa!!", classes, []string{"ERROR_STRING_PASSWORD_DIGIT"}},
+		{"classes symbol", "Aa1 ", classes, []string{"ERROR_STRING_PASSWORD_SYMBOL"}},
+		{"classes all", "", classes, []string{
+			"ERROR_STRING_PASSWORD_UPPER",
+			"ERROR_STRING_PASSWORD_LOWER",
+			"ERROR_STRING_PASSWORD_DIGIT",
+			"ERROR_STRING_PASSWORD_SYMBOL",
+		}},
+		{"MaxRepeat", "baaab", PasswordPolicy{MaxRepeat: 3}, nil},
+		{"MaxRepeat", "baaaab", PasswordPolicy{MaxRepeat: 3}, []string{"ERROR_STRING_PASSWORD_REPEAT"}},
+		{"MaxRepeat runes", "ééé", PasswordPolicy{MaxRepeat: 2}, []string{"ERROR_STRING_PASSWORD_REPEAT"}},
+		{"sequence", "xabcx", PasswordPolicy{Sequences: PasswordSequences, MaxSequenceLen: 3}, nil},
+		{"sequence", "xabcdx", PasswordPolicy{Sequences: PasswordSequences, MaxSequenceLen: 3}, []string{"ERROR_STRING_PASSWORD_SEQUENCE"}},
+		{"sequence reversed", "x4321x", PasswordPolicy{Sequences: PasswordSequences, MaxSequenceLen: 3}, []string{"ERROR_STRING_PASSWORD_SEQUENCE"}},
+		{"sequence case", "QWERty", PasswordPolicy{Sequences: PasswordSequences, MaxSequenceLen: 3}, []string{"ERROR_STRING_PASSWORD_SEQUENCE"}},
+		{"sequence no MaxSequenceLen", "abcdef", PasswordPolicy{Sequences: PasswordSequences}, nil},
+		{"denylist", "my-horse", PasswordPolicy{BannedWords: []string{"password"}}, nil},
+		{"denylist", "MyPassword1", PasswordPolicy{BannedWords: []string{"password"}}, []string{"ERROR_STRING_PASSWORD_BANNED_WORD"}},
+		{"denylist substitution", "my-p@ssw0rd", PasswordPolicy{BannedWords: []string{"password"}}, []string{"ERROR_STRING_PASSWORD_BANNED_WORD"}},
+		{"denylist once", "password-secret", PasswordPolicy{BannedWords: []string{"password", "secret"}}, []string{"ERROR_STRING_PASSWORD_BANNED_WORD"}},
+		{"denylist empty word", "anything", PasswordPolicy{BannedWords: []string{""}}, nil},
+		{"Username", "Alice-2024", PasswordPolicy{Username: "alice"}, []string{"ERROR_STRING_PASSWORD_SIMILAR"}},
+		{"Username short", "al-2024", PasswordPolicy{Username: "al"}, nil},
+		{"Email", "bob.smith!", PasswordPolicy{Email: "Bob.Smith@example.com"}, []string{"ERROR_STRING_PASSWORD_SIMILAR"}},
+		{"Email", "example.com", PasswordPolicy{Email: "bob@example.com"}, nil},
+		{"MinStrength", "correct horse battery staple", PasswordPolicy{MinStrength: 3}, nil},
+		{"MinStrength", "password", PasswordPolicy{MinStrength: 1}, []string{"ERROR_STRING_PASSWORD_STRENGTH"}},
+		{"every rule", "aaaa", PasswordPolicy{MinLen: 8, RequireUpper: true, MaxRepeat: 2}, []string{
+			"ERROR_STRING_PASSWORD_LENGTH_MIN",
+			"ERROR_STRING_PASSWORD_UPPER",
+			"ERROR_STRING_PASSWORD_REPEAT",
+		}},
+	}
+
+	for _, tt := range tests {
+		errs := StringPassword("f", tt.value, tt.policy)
+
+		var codes []string
+
+		for _, err := range errs {
+			codes = append(codes, err.Code)
+
+			if err.Field != "f" || err.Value != nil {
+				t.Errorf("%v: StringPassword(%q) error has field %q and value %v, want f and nil", tt.name, tt.value, err.Field, err.Value)
+			}
+		}
+
+		if !reflect.DeepEqual(codes, tt.codes) {
+			t.Errorf("%v: StringPassword(%q) = %q, want %q", tt.name, tt.value, codes, tt.codes)
+		}
+	}
+}
+
+func TestPasswordStrength(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"", 0},
+		{"password", 0},
+		{"p@ssw0rd", 0},
+		{"aaaaaaaaaaaaaaaa", 0},
+		{"abcdefghijklmnop", 0},
+		{"correct horse battery staple", 4},
+	}
+
+	for _, tt := range tests {
+		if got := PasswordStrength(tt.value); got != tt.want {
+			t.Errorf("PasswordStrength(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	if a, b := PasswordEntropy("Tr0ub4dor&3"), PasswordEntropy("tr0ub4dor3"); a <= b {
+		t.Errorf("PasswordEntropy(%q) = %v, want greater than %v", "Tr0ub4dor&3", a, b)
+	}
+}
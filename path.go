@@ -0,0 +1,238 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	strFilenameErrorCode         = "FILENAME"
+	strFilenameReservedErrorCode = "FILENAME_RESERVED"
+	strFilenameLengthErrorCode   = "FILENAME_LENGTH"
+	strPathErrorCode             = "PATH"
+	strPathAbsoluteErrorCode     = "PATH_ABSOLUTE"
+	strPathTraversalErrorCode    = "PATH_TRAVERSAL"
+	strPathSymlinkErrorCode      = "PATH_SYMLINK"
+	strExtensionErrorCode        = "EXTENSION"
+)
+
+const (
+	strFilenameErrorMessage         = "%v is not a valid filename"
+	strFilenameReservedErrorMessage = "%v is a reserved filename"
+	strFilenameLengthErrorMessage   = "%v is longer than %v bytes"
+	strPathErrorMessage             = "%v is not a valid path"
+	strPathAbsoluteErrorMessage     = "%v is not a relative path"
+	strPathTraversalErrorMessage    = "%v escapes its base directory"
+	strPathSymlinkErrorMessage      = "%v contains a symbolic link at %v"
+	strExtensionErrorMessage        = "%v does not have an extension in %v"
+)
+
+// pathReserved are the device names reserved by Windows, which cannot be used
+// as filenames even with an extension.
+var pathReserved = map[string]struct{}{
+	"CON": {}, "PRN": {}, "AUX": {}, "NUL": {},
+	"COM1": {}, "COM2": {}, "COM3": {}, "COM4": {}, "COM5": {}, "COM6": {}, "COM7": {}, "COM8": {}, "COM9": {},
+	"LPT1": {}, "LPT2": {}, "LPT3": {}, "LPT4": {}, "LPT5": {}, "LPT6": {}, "LPT7": {}, "LPT8": {}, "LPT9": {},
+}
+
+// StringFilename returns error if value is not a filename safe on both Unix
+// and Windows, otherwise nil. A safe filename is not empty, . or .., has no
+// separators / or \, control characters or any of <>:"|?*, does not end with
+// a dot or space, is not a reserved name such as CON, NUL or COM1, with or
+// without an extension, and if maxLen>0 is at most maxLen bytes. The invalid
+// Char and its byte Index are reported in Args.
+func StringFilename(field, value string, maxLen int) *ErrValidation {
+	if maxLen > 0 && len(value) > maxLen {
+		args := struct {
+			MaxLen int
+		}{
+			maxLen,
+		}
+		code := fmt.Sprintf(strErrorCode, strFilenameLengthErrorCode)
+		message := fmt.Sprintf(strFilenameLengthErrorMessage, field, maxLen)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	index := strings.IndexFunc(value, func(c rune) bool {
+		return c == utf8.RuneError || unicode.IsControl(c) || strings.ContainsRune(`/\<>:"|?*`, c)
+	})
+
+	if index < 0 && value != "" && value != "." && value != ".." && strings.TrimRight(value, ". ") != value {
+		index = len(value) - 1
+	}
+
+	if index >= 0 || value == "" || value == "." || value == ".." {
+		args := struct {
+			Char  string
+			Index int
+		}{
+			"", index,
+		}
+
+		if index >= 0 {
+			c, _ := utf8.DecodeRuneInString(value[index:])
+			args.Char = string(c)
+		}
+
+		code := fmt.Sprintf(strErrorCode, strFilenameErrorCode)
+		message := fmt.Sprintf(strFilenameErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	name, _, _ := strings.Cut(value, ".")
+
+	if _, ok := pathReserved[strings.ToUpper(strings.TrimRight(name, " "))]; ok {
+		args := struct {
+			Name string
+		}{
+			strings.ToUpper(strings.TrimRight(name, " ")),
+		}
+		code := fmt.Sprintf(strErrorCode, strFilenameReservedErrorCode)
+		message := fmt.Sprintf(strFilenameReservedErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringRelativePath returns error if value is empty or has control
+// characters, eg. NUL, if it is an absolute path, on either Unix or Windows,
+// eg. /etc, \\host\share or C:\, or if it escapes its base directory after
+// cleaning, eg. a/../../b, otherwise nil. Both / and \ are treated as
+// separators. The invalid Char and its byte Index are reported in Args.
+func StringRelativePath(field, value string) *ErrValidation {
+	if index := strings.IndexFunc(value, unicode.IsControl); index >= 0 || value == "" {
+		args := struct {
+			Char  string
+			Index int
+		}{
+			"", index,
+		}
+
+		if index >= 0 {
+			c, _ := utf8.DecodeRuneInString(value[index:])
+			args.Char = string(c)
+		}
+
+		code := fmt.Sprintf(strErrorCode, strPathErrorCode)
+		message := fmt.Sprintf(strPathErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	p := strings.ReplaceAll(value, `\`, "/")
+
+	if strings.HasPrefix(p, "/") || len(p) >= 2 && p[1] == ':' && (p[0]|0x20 >= 'a' && p[0]|0x20 <= 'z') {
+		args := struct{}{}
+		code := fmt.Sprintf(strErrorCode, strPathAbsoluteErrorCode)
+		message := fmt.Sprintf(strPathAbsoluteErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	if p = path.Clean(p); p == ".." || strings.HasPrefix(p, "../") {
+		args := struct{}{}
+		code := fmt.Sprintf(strErrorCode, strPathTraversalErrorCode)
+		message := fmt.Sprintf(strPathTraversalErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringPathInDir returns error if value is not a relative path as in
+// StringRelativePath, or if, resolved against the real directory dir, it
+// escapes dir through a symbolic link, otherwise nil. If noSymlinks is true,
+// any symbolic link along value is an error instead, and its Path relative to
+// dir is reported in Args. Only the parts of value that exist are checked, so
+// value may name a file yet to be created. StringPathInDir panics if dir
+// cannot be resolved.
+func StringPathInDir(field, value, dir string, noSymlinks bool) *ErrValidation {
+	if err := StringRelativePath(field, value); err != nil {
+		return err
+	}
+
+	root, err := filepath.EvalSymlinks(dir)
+
+	if err != nil {
+		panic("dir must be an existing directory")
+	}
+
+	p := root
+	var rel string
+
+	for _, name := range strings.Split(path.Clean(strings.ReplaceAll(value, `\`, "/")), "/") {
+		info, err := os.Lstat(filepath.Join(p, name))
+
+		if err != nil {
+			break
+		}
+
+		p, rel = filepath.Join(p, name), path.Join(rel, name)
+
+		if info.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+
+		if noSymlinks {
+			args := struct {
+				Path string
+			}{
+				rel,
+			}
+			code := fmt.Sprintf(strErrorCode, strPathSymlinkErrorCode)
+			message := fmt.Sprintf(strPathSymlinkErrorMessage, field, rel)
+
+			return NewError(code, args, message, field, value)
+		}
+
+		// A link that cannot be resolved, eg. a dangling one, may still point
+		// outside dir once its target is created.
+		p, err = filepath.EvalSymlinks(p)
+
+		if r, _ := filepath.Rel(root, p); err != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+			args := struct{}{}
+			code := fmt.Sprintf(strErrorCode, strPathTraversalErrorCode)
+			message := fmt.Sprintf(strPathTraversalErrorMessage, field)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringExtension returns error if value does not end with any of extensions,
+// eg. .jpg or .tar.gz, compared case-insensitively, otherwise nil. A value made
+// up of only the extension, eg. .jpg, does not have it. StringExtension panics
+// if an extension does not start with a dot.
+func StringExtension(field, value string, extensions []string) *ErrValidation {
+	for _, ext := range extensions {
+		if !strings.HasPrefix(ext, ".") {
+			panic("extensions must start with a dot")
+		}
+
+		if len(value) > len(ext) && strings.EqualFold(value[len(value)-len(ext):], ext) {
+			return nil
+		}
+	}
+
+	args := struct {
+		Extensions []string
+	}{
+		extensions,
+	}
+	code := fmt.Sprintf(strErrorCode, strExtensionErrorCode)
+	message := fmt.Sprintf(strExtensionErrorMessage, field, extensions)
+
+	return NewError(code, args, message, field, value)
+}
@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStringFilename(t *testing.T) {
+	tests := []struct {
+		value  string
+		maxLen int
+		code   string
+	}{
+		{"report.pdf", 0, ""},
+		{"résumé 2024.docx", 0, ""},
+		{".gitignore", 0, ""},
+		{"a", 1, ""},
+		{"ab", 1, "ERROR_STRING_FILENAME_LENGTH"},
+		{strings.Repeat("a", 256), 255, "ERROR_STRING_FILENAME_LENGTH"},
+		{"", 0, "ERROR_STRING_FILENAME"},
+		{".", 0, "ERROR_STRING_FILENAME"},
+		{"..", 0, "ERROR_STRING_FILENAME"},
+		{"a/b", 0, "ERROR_STRING_FILENAME"},
+		{`a\b`, 0, "ERROR_STRING_FILENAME"},
+		{"a:b", 0, "ERROR_STRING_FILENAME"},
+		{"a?.txt", 0, "ERROR_STRING_FILENAME"},
+		{"a\x00b", 0, "ERROR_STRING_FILENAME"},
+		{"name.", 0, "ERROR_STRING_FILENAME"},
+		{"name ", 0, "ERROR_STRING_FILENAME"},
+		{"CON", 0, "ERROR_STRING_FILENAME_RESERVED"},
+		{"nul.txt", 0, "ERROR_STRING_FILENAME_RESERVED"},
+		{"com1.tar.gz", 0, "ERROR_STRING_FILENAME_RESERVED"},
+		{"console.txt", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringFilename("f", tt.value, tt.maxLen)); code != tt.code {
+			t.Errorf("StringFilename(%q, %v) = %q, want %q", tt.value, tt.maxLen, code, tt.code)
+		}
+	}
+}
+
+func TestStringRelativePath(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"a/b/c.txt", ""},
+		{`a\b\c.txt`, ""},
+		{"a/../b", ""},
+		{".", ""},
+		{"..a/b", ""},
+		{"..", "ERROR_STRING_PATH_TRAVERSAL"},
+		{"../a", "ERROR_STRING_PATH_TRAVERSAL"},
+		{"a/../../b", "ERROR_STRING_PATH_TRAVERSAL"},
+		{`a\..\..\b`, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"./../a", "ERROR_STRING_PATH_TRAVERSAL"},
+		{"/etc/passwd", "ERROR_STRING_PATH_ABSOLUTE"},
+		{`\\host\share`, "ERROR_STRING_PATH_ABSOLUTE"},
+		{`C:\Windows`, "ERROR_STRING_PATH_ABSOLUTE"},
+		{"c:file", "ERROR_STRING_PATH_ABSOLUTE"},
+		{"", "ERROR_STRING_PATH"},
+		{"a\x00.txt", "ERROR_STRING_PATH"},
+		{"a/\nb", "ERROR_STRING_PATH"},
+		{"a\u0085b", "ERROR_STRING_PATH"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringRelativePath("f", tt.value)); code != tt.code {
+			t.Errorf("StringRelativePath(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringPathInDir(t *testing.T) {
+	dir, outside := t.TempDir(), t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "sub", "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	links := map[string]string{
+		"in":       "sub",
+		"up":       "..",
+		"out":      outside,
+		"dangling": filepath.Join(dir, "missing"),
+	}
+
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(dir, name)); err != nil {
+			t.Skipf("cannot create symbolic links: %v", err)
+		}
+	}
+
+	tests := []struct {
+		value      string
+		noSymlinks bool
+		code       string
+	}{
+		{"sub/file", false, ""},
+		{"sub/new.txt", false, ""},
+		{"new/dir/file", false, ""},
+		{"in/file", false, ""},
+		{"in/file", true, "ERROR_STRING_PATH_SYMLINK"},
+		{"sub/file", true, ""},
+		{"up/x", false, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"out/file", false, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"out/file", true, "ERROR_STRING_PATH_SYMLINK"},
+		{"dangling", false, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"dangling/file", false, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"../x", false, "ERROR_STRING_PATH_TRAVERSAL"},
+		{"/etc", false, "ERROR_STRING_PATH_ABSOLUTE"},
+		{"", false, "ERROR_STRING_PATH"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringPathInDir("f", tt.value, dir, tt.noSymlinks)); code != tt.code {
+			t.Errorf("StringPathInDir(%q, %v) = %q, want %q", tt.value, tt.noSymlinks, code, tt.code)
+		}
+	}
+
+	// dir itself may be reached through a symbolic link.
+	root := filepath.Join(outside, "root")
+
+	if err := os.Symlink(dir, root); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := StringPathInDir("f", "in/file", root, false); err != nil {
+		t.Errorf("StringPathInDir(%q) through a linked dir = %v, want nil", "in/file", err)
+	}
+}
+
+func TestStringExtension(t *testing.T) {
+	tests := []struct {
+		value      string
+		extensions []string
+		code       string
+	}{
+		{"photo.jpg", []string{".jpg", ".png"}, ""},
+		{"PHOTO.JPG", []string{".jpg"}, ""},
+		{"backup.tar.gz", []string{".tar.gz"}, ""},
+		{"backup.gz", []string{".tar.gz"}, "ERROR_STRING_EXTENSION"},
+		{".jpg", []string{".jpg"}, "ERROR_STRING_EXTENSION"},
+		{"photo.jpeg", []string{".jpg"}, "ERROR_STRING_EXTENSION"},
+		{"photo", nil, "ERROR_STRING_EXTENSION"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringExtension("f", tt.value, tt.extensions)); code != tt.code {
+			t.Errorf("StringExtension(%q, %v) = %q, want %q", tt.value, tt.extensions, code, tt.code)
+		}
+	}
+}
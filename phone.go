@@ -0,0 +1,192 @@
+package validation
+
+//go:generate go run gen_phone.go
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	strPhoneFormatErrorCode = "PHONE_FORMAT"
+	strPhoneRegionErrorCode = "PHONE_REGION"
+	strPhoneLengthErrorCode = "PHONE_LENGTH"
+	strPhonePrefixErrorCode = "PHONE_PREFIX"
+	strPhoneTypeErrorCode   = "PHONE_TYPE"
+)
+
+const (
+	strPhoneFormatErrorMessage = "%v is not a phone number"
+	strPhoneRegionErrorMessage = "%v has no known region"
+	strPhoneLengthErrorMessage = "%v has an invalid length for %v"
+	strPhonePrefixErrorMessage = "%v has an invalid prefix for %v"
+	strPhoneTypeErrorMessage   = "%v is not a phone number of type %v"
+)
+
+// phoneRegion is the metadata of phone numbers of a region, generated from
+// phone_metadata.txt.
+type phoneRegion struct {
+	code, trunk string
+	rules       []phoneRule
+}
+
+// phoneRule matches national significant numbers of a type by their leading
+// digits and lengths.
+type phoneRule struct {
+	kind     string
+	prefixes []string
+	lengths  []int
+}
+
+// StringPhone returns error if value is not a phone number, otherwise nil.
+// International numbers start with + or 00 followed by the calling code, eg.
+// +60 12-345 6789. Other numbers are parsed as national numbers of region, an
+// ISO 3166-1 alpha-2 code, eg. 012-345 6789 with region MY. Spaces, hyphens,
+// dots, slashes and parentheses are ignored, and so is a trunk prefix 0 in
+// parentheses after the calling code, eg. +44 (0)20 7946 0958.
+func StringPhone(field, value, region string) *ErrValidation {
+	_, _, _, err := phoneParse(field, value, region)
+
+	return err
+}
+
+// StringPhoneType returns error if value is not a phone number, as in
+// StringPhone, or is not of any of types, otherwise nil. types are mobile,
+// fixed, voip and any, where any is used for regions such as US in which
+// mobile and fixed-line numbers cannot be told apart.
+func StringPhoneType(field, value, region string, types []string) *ErrValidation {
+	_, r, kind, err := phoneParse(field, value, region)
+
+	if err != nil {
+		return err
+	}
+
+	for _, t := range types {
+		if t == kind {
+			return nil
+		}
+	}
+
+	args := struct {
+		Region string
+		Type   string
+	}{
+		r, kind,
+	}
+	code := fmt.Sprintf(strErrorCode, strPhoneTypeErrorCode)
+	message := fmt.Sprintf(strPhoneTypeErrorMessage, field, types)
+
+	return NewError(code, args, message, field, value)
+}
+
+// PhoneE164 returns value in the E.164 format, eg. +60123456789, or error if
+// value is not a phone number, as in StringPhone.
+func PhoneE164(field, value, region string) (string, *ErrValidation) {
+	e164, _, _, err := phoneParse(field, value, region)
+
+	return e164, err
+}
+
+// phoneParse parses value as a phone number, and returns the number in the
+// E.164 format and its region and type.
+func phoneParse(field, value, region string) (string, string, string, *ErrValidation) {
+	args := struct {
+		Region string
+		Type   string
+	}{
+		strings.ToUpper(region), "",
+	}
+	s := strings.NewReplacer(" ", "", "-", "", ".", "", "/", "").Replace(value)
+	intl := false
+
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s, intl = s[1:], true
+	case strings.HasPrefix(s, "00"):
+		s, intl = s[2:], true
+	}
+
+	// A trunk prefix after the calling code, eg. +44 (0)20, is dialled only
+	// within the country.
+	if i := strings.Index(s, "(0)"); intl && i >= 1 && i <= 3 && strDigits(s[:i]) {
+		s = s[:i] + s[i+3:]
+	}
+
+	s = strings.NewReplacer("(", "", ")", "").Replace(s)
+
+	if s == "" || !strDigits(s) {
+		code := fmt.Sprintf(strErrorCode, strPhoneFormatErrorCode)
+		message := fmt.Sprintf(strPhoneFormatErrorMessage, field)
+
+		return "", "", "", NewError(code, args, message, field, value)
+	}
+
+	var candidates []string
+
+	if intl {
+		// Calling codes are prefix-free, so at most one of them matches.
+		for i := 1; i <= 3 && i < len(s); i++ {
+			if regions, ok := phoneCallingCodes[s[:i]]; ok {
+				candidates = regions
+				s = s[i:]
+
+				break
+			}
+		}
+	} else if r, ok := phoneRegions[args.Region]; ok {
+		candidates = []string{args.Region}
+
+		if r.trunk != "" {
+			s = strings.TrimPrefix(s, r.trunk)
+		}
+	}
+
+	if len(candidates) == 0 {
+		code := fmt.Sprintf(strErrorCode, strPhoneRegionErrorCode)
+		message := fmt.Sprintf(strPhoneRegionErrorMessage, field)
+
+		return "", "", "", NewError(code, args, message, field, value)
+	}
+
+	args.Region = candidates[0]
+	prefixed := false
+
+	for _, name := range candidates {
+		r := phoneRegions[name]
+
+		for _, rule := range r.rules {
+			if !phoneHasPrefix(s, rule.prefixes) {
+				continue
+			}
+
+			prefixed = true
+
+			for _, l := range rule.lengths {
+				if len(s) == l {
+					return "+" + r.code + s, name, rule.kind, nil
+				}
+			}
+		}
+	}
+
+	code := fmt.Sprintf(strErrorCode, strPhonePrefixErrorCode)
+	message := fmt.Sprintf(strPhonePrefixErrorMessage, field, args.Region)
+
+	if prefixed {
+		code = fmt.Sprintf(strErrorCode, strPhoneLengthErrorCode)
+		message = fmt.Sprintf(strPhoneLengthErrorMessage, field, args.Region)
+	}
+
+	return "", "", "", NewError(code, args, message, field, value)
+}
+
+// phoneHasPrefix reports whether s starts with any of prefixes.
+func phoneHasPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+
+	return false
+}
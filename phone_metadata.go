@@ -0,0 +1,95 @@
+// Code generated by gen_phone.go from phone_metadata.txt. DO NOT EDIT.
+
+package validation
+
+var phoneRegions = map[string]phoneRegion{
+	"AU": {"61", "0", []phoneRule{
+		{"mobile", []string{"4"}, []int{9}},
+		{"fixed", []string{"2", "3", "7", "8"}, []int{9}},
+	}},
+	"CA": {"1", "1", []phoneRule{
+		{"any", []string{"2", "3", "4", "5", "6", "7", "8", "9"}, []int{10}},
+	}},
+	"CN": {"86", "0", []phoneRule{
+		{"mobile", []string{"13", "14", "15", "16", "17", "18", "19"}, []int{11}},
+		{"fixed", []string{"10"}, []int{10}},
+		{"fixed", []string{"2", "3", "4", "5", "6", "7", "8", "9"}, []int{9, 10, 11}},
+	}},
+	"DE": {"49", "0", []phoneRule{
+		{"mobile", []string{"15", "16", "17"}, []int{10, 11}},
+		{"fixed", []string{"2", "3", "4", "5", "6", "7", "8", "9"}, []int{6, 7, 8, 9, 10, 11}},
+	}},
+	"FR": {"33", "0", []phoneRule{
+		{"mobile", []string{"6", "7"}, []int{9}},
+		{"fixed", []string{"1", "2", "3", "4", "5", "9"}, []int{9}},
+	}},
+	"GB": {"44", "0", []phoneRule{
+		{"mobile", []string{"7"}, []int{10}},
+		{"fixed", []string{"1", "2", "3"}, []int{9, 10}},
+	}},
+	"HK": {"852", "", []phoneRule{
+		{"mobile", []string{"5", "6", "7", "9"}, []int{8}},
+		{"fixed", []string{"2", "3"}, []int{8}},
+	}},
+	"ID": {"62", "0", []phoneRule{
+		{"mobile", []string{"8"}, []int{9, 10, 11, 12}},
+		{"fixed", []string{"2", "3", "4", "5", "6", "7", "9"}, []int{7, 8, 9, 10, 11}},
+	}},
+	"IN": {"91", "0", []phoneRule{
+		{"mobile", []string{"6", "7", "8", "9"}, []int{10}},
+		{"fixed", []string{"1", "2", "3", "4", "5"}, []int{10}},
+	}},
+	"JP": {"81", "0", []phoneRule{
+		{"mobile", []string{"70", "80", "90"}, []int{10}},
+		{"fixed", []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}, []int{9}},
+	}},
+	"MY": {"60", "0", []phoneRule{
+		{"mobile", []string{"11"}, []int{10}},
+		{"mobile", []string{"10", "12", "13", "14", "16", "17", "18", "19"}, []int{9}},
+		{"fixed", []string{"3"}, []int{9}},
+		{"fixed", []string{"4", "5", "6", "7", "8", "9"}, []int{8}},
+	}},
+	"NL": {"31", "0", []phoneRule{
+		{"mobile", []string{"6"}, []int{9}},
+		{"fixed", []string{"1", "2", "3", "4", "5", "7"}, []int{9}},
+	}},
+	"PH": {"63", "0", []phoneRule{
+		{"mobile", []string{"9"}, []int{10}},
+		{"fixed", []string{"2", "3", "4", "5", "6", "7", "8"}, []int{8, 9}},
+	}},
+	"SG": {"65", "", []phoneRule{
+		{"mobile", []string{"8", "9"}, []int{8}},
+		{"fixed", []string{"6"}, []int{8}},
+		{"voip", []string{"3"}, []int{8}},
+	}},
+	"TH": {"66", "0", []phoneRule{
+		{"mobile", []string{"6", "8", "9"}, []int{9}},
+		{"fixed", []string{"2", "3", "4", "5", "7"}, []int{8}},
+	}},
+	"US": {"1", "1", []phoneRule{
+		{"any", []string{"2", "3", "4", "5", "6", "7", "8", "9"}, []int{10}},
+	}},
+	"VN": {"84", "0", []phoneRule{
+		{"mobile", []string{"3", "5", "7", "8", "9"}, []int{9}},
+		{"fixed", []string{"2"}, []int{10}},
+	}},
+}
+
+var phoneCallingCodes = map[string][]string{
+	"1":   []string{"US", "CA"},
+	"31":  []string{"NL"},
+	"33":  []string{"FR"},
+	"44":  []string{"GB"},
+	"49":  []string{"DE"},
+	"60":  []string{"MY"},
+	"61":  []string{"AU"},
+	"62":  []string{"ID"},
+	"63":  []string{"PH"},
+	"65":  []string{"SG"},
+	"66":  []string{"TH"},
+	"81":  []string{"JP"},
+	"84":  []string{"VN"},
+	"852": []string{"HK"},
+	"86":  []string{"CN"},
+	"91":  []string{"IN"},
+}
@@ -0,0 +1,61 @@
+package validation
+
+import "testing"
+
+func TestPhoneE164(t *testing.T) {
+	tests := []struct {
+		value  string
+		region string
+		want   string
+		code   string
+	}{
+		{"+60 12-345 6789", "", "+60123456789", ""},
+		{"0060 12 345 6789", "", "+60123456789", ""},
+		{"012-345 6789", "MY", "+60123456789", ""},
+		{"012-345 6789", "my", "+60123456789", ""},
+		{"+44 (0)20 7946 0958", "", "+442079460958", ""},
+		{"+44(0)7911 123456", "", "+447911123456", ""},
+		{"(020) 7946 0958", "GB", "+442079460958", ""},
+		{"+1 (415) 555-2671", "", "+14155552671", ""},
+		{"6123 4567", "SG", "+6561234567", ""},
+		{"+44 (0)(0)20 7946 0958", "", "", "ERROR_STRING_PHONE_PREFIX"},
+		{"+44 9123 456789", "", "", "ERROR_STRING_PHONE_PREFIX"},
+		{"+44 7911 12345", "", "", "ERROR_STRING_PHONE_LENGTH"},
+		{"+999 123 4567", "", "", "ERROR_STRING_PHONE_REGION"},
+		{"012-345 6789", "", "", "ERROR_STRING_PHONE_REGION"},
+		{"+60 12-345 678x", "", "", "ERROR_STRING_PHONE_FORMAT"},
+		{"+", "", "", "ERROR_STRING_PHONE_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		got, err := PhoneE164("f", tt.value, tt.region)
+
+		if code := errCode(err); got != tt.want || code != tt.code {
+			t.Errorf("PhoneE164(%q, %q) = %q, %q, want %q, %q", tt.value, tt.region, got, code, tt.want, tt.code)
+		}
+
+		if code := errCode(StringPhone("f", tt.value, tt.region)); code != tt.code {
+			t.Errorf("StringPhone(%q, %q) = %q, want %q", tt.value, tt.region, code, tt.code)
+		}
+	}
+}
+
+func TestStringPhoneType(t *testing.T) {
+	tests := []struct {
+		value string
+		types []string
+		code  string
+	}{
+		{"+65 9123 4567", []string{"mobile"}, ""},
+		{"+65 6123 4567", []string{"mobile"}, "ERROR_STRING_PHONE_TYPE"},
+		{"+65 3123 4567", []string{"mobile", "voip"}, ""},
+		{"+1 415 555 2671", []string{"any"}, ""},
+		{"+65 123", []string{"mobile"}, "ERROR_STRING_PHONE_PREFIX"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringPhoneType("f", tt.value, "", tt.types)); code != tt.code {
+			t.Errorf("StringPhoneType(%q, %v) = %q, want %q", tt.value, tt.types, code, tt.code)
+		}
+	}
+}
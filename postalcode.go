@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"regexp"
+)
+
+const (
+	strPostalCodeErrorCode = "POSTAL_CODE"
+)
+
+const (
+	strPostalCodeErrorMessage = "%v is not a postal code of %v"
+)
+
+// postalCodePatterns maps countries to the format of their postal codes, with
+// spaces and hyphens removed.
+var postalCodePatterns = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^\d{4}$`),
+	"BE": regexp.MustCompile(`^\d{4}$`),
+	"BG": regexp.MustCompile(`^\d{4}$`),
+	"CY": regexp.MustCompile(`^\d{4}$`),
+	"CZ": regexp.MustCompile(`^\d{5}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"DK": regexp.MustCompile(`^\d{4}$`),
+	"EE": regexp.MustCompile(`^\d{5}$`),
+	"ES": regexp.MustCompile(`^(0[1-9]|[1-4]\d|5[0-2])\d{3}$`),
+	"FI": regexp.MustCompile(`^\d{5}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"GR": regexp.MustCompile(`^\d{5}$`),
+	"HR": regexp.MustCompile(`^\d{5}$`),
+	"HU": regexp.MustCompile(`^\d{4}$`),
+	"ID": regexp.MustCompile(`^[1-9]\d{4}$`),
+	"IE": regexp.MustCompile(`^([AC-FHKNPRTV-Y]\d{2}|D6W)[0-9AC-FHKNPRTV-Y]{4}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"LT": regexp.MustCompile(`^(LT)?\d{5}$`),
+	"LU": regexp.MustCompile(`^(L)?\d{4}$`),
+	"LV": regexp.MustCompile(`^(LV)?\d{4}$`),
+	"MT": regexp.MustCompile(`^[A-Z]{3}\d{4}$`),
+	"MY": regexp.MustCompile(`^\d{5}$`),
+	"NL": regexp.MustCompile(`^[1-9]\d{3}[A-Z]{2}$`),
+	"PL": regexp.MustCompile(`^\d{5}$`),
+	"PT": regexp.MustCompile(`^\d{7}$`),
+	"RO": regexp.MustCompile(`^\d{6}$`),
+	"SE": regexp.MustCompile(`^\d{5}$`),
+	"SG": regexp.MustCompile(`^\d{6}$`),
+	"SI": regexp.MustCompile(`^(SI)?\d{4}$`),
+	"SK": regexp.MustCompile(`^\d{5}$`),
+}
+
+var postalCodeRules = &countryRules{rules: postalCodePatternRules()}
+
+// RegisterPostalCodeRule sets the rule used by StringPostalCode to check postal
+// codes of country, an ISO 3166-1 alpha-2 code, replacing any existing rule.
+// RegisterPostalCodeRule is safe for concurrent use.
+func RegisterPostalCodeRule(country string, rule CountryRule) {
+	postalCodeRules.set(country, rule)
+}
+
+// StringPostalCode returns error if value is not a postal code of country, an
+// ISO 3166-1 alpha-2 code, otherwise nil. Spaces and hyphens are ignored, and
+// letters are matched case-insensitively. Rules are built in for ID, MY, SG and
+// the EU member states, and can be added with RegisterPostalCodeRule.
+// Countries without a rule are rejected.
+func StringPostalCode(field, value, country string) *ErrValidation {
+	return countryCheck(field, value, country, postalCodeRules, strPostalCodeErrorCode, strPostalCodeErrorMessage)
+}
+
+// postalCodePatternRules returns the rules of the countries in
+// postalCodePatterns.
+func postalCodePatternRules() map[string]CountryRule {
+	rules := make(map[string]CountryRule)
+
+	for country, pattern := range postalCodePatterns {
+		pattern := pattern
+
+		rules[country] = func(value string) string {
+			if !pattern.MatchString(value) {
+				return countryComponentFormat
+			}
+
+			return ""
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const (
+	sliceErrorCode = "ERROR_SLICE_%v"
+)
+
+const (
+	sliceLenMinErrorCode     = "LENGTH_MIN"
+	sliceLenMaxErrorCode     = "LENGTH_MAX"
+	sliceLenBetweenErrorCode = "LENGTH_BETWEEN"
+)
+
+const (
+	sliceLenMinErrorMessage     = "%v has fewer than %v element(s)"
+	sliceLenMaxErrorMessage     = "%v has more than %v element(s)"
+	sliceLenBetweenErrorMessage = "number of elements in %v is not between %v and %v"
+)
+
+// SliceLenMin returns error if values has fewer than min elements, otherwise
+// nil. SliceLenMin panics if values is not a slice or an array.
+func SliceLenMin(field string, values interface{}, min int) *ErrValidation {
+	l := sliceValue(values).Len()
+
+	if l < min {
+		args := struct {
+			Min, Len int
+		}{
+			min, l,
+		}
+		code := fmt.Sprintf(sliceErrorCode, sliceLenMinErrorCode)
+		message := fmt.Sprintf(sliceLenMinErrorMessage, field, min)
+
+		return NewError(code, args, message, field, nil)
+	}
+
+	return nil
+}
+
+// SliceLenMax returns error if values has more than max elements, otherwise
+// nil. SliceLenMax panics if values is not a slice or an array.
+func SliceLenMax(field string, values interface{}, max int) *ErrValidation {
+	l := sliceValue(values).Len()
+
+	if l > max {
+		args := struct {
+			Max, Len int
+		}{
+			max, l,
+		}
+		code := fmt.Sprintf(sliceErrorCode, sliceLenMaxErrorCode)
+		message := fmt.Sprintf(sliceLenMaxErrorMessage, field, max)
+
+		return NewError(code, args, message, field, nil)
+	}
+
+	return nil
+}
+
+// SliceLenBetween returns error if values has fewer than min or more than max
+// elements, otherwise nil. SliceLenBetween panics if values is not a slice or
+// an array.
+func SliceLenBetween(field string, values interface{}, min, max int) *ErrValidation {
+	l := sliceValue(values).Len()
+
+	if l < min || l > max {
+		args := struct {
+			Min, Max, Len int
+		}{
+			min, max, l,
+		}
+		code := fmt.Sprintf(sliceErrorCode, sliceLenBetweenErrorCode)
+		message := fmt.Sprintf(sliceLenBetweenErrorMessage, field, min, max)
+
+		return NewError(code, args, message, field, nil)
+	}
+
+	return nil
+}
+
+// SliceEach calls fn for every element of values, with the index of the element
+// appended to field, eg. tags[2]. SliceEach returns the first error returned by
+// fn, otherwise nil. SliceEach panics if values is not a slice or an array.
+func SliceEach(field string, values interface{}, fn func(field string, value interface{}) *ErrValidation) *ErrValidation {
+	v := sliceValue(values)
+
+	for i := 0; i < v.Len(); i++ {
+		if err := fn(sliceField(field, i), v.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sliceValue returns the reflect.Value of values, and panics if values is not a
+// slice or an array.
+func sliceValue(values interface{}) reflect.Value {
+	v := reflect.ValueOf(values)
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		panic("values must be a slice or an array")
+	}
+
+	return v
+}
+
+// sliceField returns the field path of the i-th element of field.
+func sliceField(field string, i int) string {
+	return fmt.Sprintf("%v[%v]", field, i)
+}
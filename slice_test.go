@@ -0,0 +1,120 @@
+package validation
+
+import "testing"
+
+func TestSliceLen(t *testing.T) {
+	tests := []struct {
+		values   interface{}
+		min, max int
+		code     string
+	}{
+		{[]int{1, 2}, 1, 3, ""},
+		{[]int{1, 2}, 2, 2, ""},
+		{[2]string{"a", "b"}, 1, 2, ""},
+		{[]int{}, 1, 3, "ERROR_SLICE_LENGTH_BETWEEN"},
+		{[]string{"a", "b", "c", "d"}, 1, 3, "ERROR_SLICE_LENGTH_BETWEEN"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(SliceLenBetween("f", tt.values, tt.min, tt.max)); code != tt.code {
+			t.Errorf("SliceLenBetween(%v, %v, %v) = %q, want %q", tt.values, tt.min, tt.max, code, tt.code)
+		}
+	}
+
+	if code := errCode(SliceLenMin("f", []int{1}, 2)); code != "ERROR_SLICE_LENGTH_MIN" {
+		t.Errorf("SliceLenMin([1], 2) = %q, want %q", code, "ERROR_SLICE_LENGTH_MIN")
+	}
+
+	if code := errCode(SliceLenMax("f", []int{1, 2}, 1)); code != "ERROR_SLICE_LENGTH_MAX" {
+		t.Errorf("SliceLenMax([1 2], 1) = %q, want %q", code, "ERROR_SLICE_LENGTH_MAX")
+	}
+}
+
+func TestSliceEach(t *testing.T) {
+	err := SliceEach("tags", []string{"a", "", "b"}, func(field string, value interface{}) *ErrValidation {
+		return StringNotEmpty(field, value.(string))
+	})
+
+	if err == nil || err.Field != "tags[1]" {
+		t.Errorf("SliceEach() = %v, want error on tags[1]", err)
+	}
+
+	err = StringEach("tags", []string{"a", "b", ""}, StringNotEmpty)
+
+	if err == nil || err.Field != "tags[2]" {
+		t.Errorf("StringEach() = %v, want error on tags[2]", err)
+	}
+}
+
+func TestStringSliceMembership(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(field string, a, b []string) *ErrValidation
+		a, b []string
+		code string
+	}{
+		{"StringSubsetOf", StringSubsetOf, []string{"a", "b"}, []string{"a", "b", "c"}, ""},
+		{"StringSubsetOf", StringSubsetOf, []string{"a", "d"}, []string{"a", "b", "c"}, "ERROR_STRING_SUBSET_OF"},
+		{"StringSubsetOf", StringSubsetOf, []string{"A"}, []string{"a"}, "ERROR_STRING_SUBSET_OF"},
+		{"StringSubsetOfIgnoreCase", StringSubsetOfIgnoreCase, []string{"A"}, []string{"a"}, ""},
+		{"StringContainsAll", StringContainsAll, []string{"a", "b", "c"}, []string{"c", "a"}, ""},
+		{"StringContainsAll", StringContainsAll, []string{"a", "b"}, []string{"c"}, "ERROR_STRING_CONTAINS_ALL"},
+		{"StringContainsAllIgnoreCase", StringContainsAllIgnoreCase, []string{"A", "B"}, []string{"b"}, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.fn("f", tt.a, tt.b)); code != tt.code {
+			t.Errorf("%v(%q, %q) = %q, want %q", tt.name, tt.a, tt.b, code, tt.code)
+		}
+	}
+}
+
+func TestStringSorted(t *testing.T) {
+	tests := []struct {
+		values []string
+		code   string
+	}{
+		{nil, ""},
+		{[]string{"a", "a", "b"}, ""},
+		{[]string{"b", "a"}, "ERROR_STRING_SORTED"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringSorted("f", tt.values)); code != tt.code {
+			t.Errorf("StringSorted(%q) = %q, want %q", tt.values, code, tt.code)
+		}
+	}
+}
+
+func TestNumberSliceMembership(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ErrValidation
+		code string
+	}{
+		{"NumberNoDuplicate", NumberNoDuplicate("f", []int{1, 2, 3}), ""},
+		{"NumberNoDuplicate", NumberNoDuplicate("f", []float64{1.5, 2, 1.5}), "ERROR_NUMBER_NO_DUPLICATE"},
+		{"NumberSubsetOf", NumberSubsetOf("f", []int{1, 3}, []int{1, 2, 3}), ""},
+		{"NumberSubsetOf", NumberSubsetOf("f", []int{1, 4}, []int{1, 2, 3}), "ERROR_NUMBER_SUBSET_OF"},
+		{"NumberContainsAll", NumberContainsAll("f", []uint{1, 2, 3}, []uint{3}), ""},
+		{"NumberContainsAll", NumberContainsAll("f", []uint{1, 2}, []uint{3}), "ERROR_NUMBER_CONTAINS_ALL"},
+		{"NumberSorted", NumberSorted("f", []int{-1, 0, 0, 5}), ""},
+		{"NumberSorted", NumberSorted("f", []float32{1, 0.5}), "ERROR_NUMBER_SORTED"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.err); code != tt.code {
+			t.Errorf("%v() = %q, want %q", tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestNumberSliceValuePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NumberSubsetOf([]int, []int64) did not panic")
+		}
+	}()
+
+	NumberSubsetOf("f", []int{1}, []int64{1})
+}
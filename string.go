@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
 )
 
 const (
@@ -21,6 +24,10 @@ const (
 	strOnlyNumericErrorCode      = "ONLY_NUMERIC"
 	strInErrorCode               = "IN"
 	strNoDuplicateErrorCode      = "NO_DUPLICATE"
+	strSubsetOfErrorCode         = "SUBSET_OF"
+	strContainsAllErrorCode      = "CONTAINS_ALL"
+	strSortedErrorCode           = "SORTED"
+	strNormalizedErrorCode       = "NORMALIZED"
 )
 
 const (
@@ -34,8 +41,49 @@ const (
 	strOnlyNumericErrorMessage      = "%v contains non-numeric character(s)"
 	strInErrorMessage               = "%v has no match in %v"
 	strNoDuplicateErrorMessage      = "%v has duplicated values"
+	strSubsetOfErrorMessage         = "%v has value(s) with no match in %v"
+	strContainsAllErrorMessage      = "%v does not contain all of %v"
+	strSortedErrorMessage           = "%v is not sorted in ascending order"
+	strNormalizedErrorMessage       = "%v is not in Unicode normalization form %v"
+)
+
+// InvalidChar is an invalid character reported by the *All family of
+// functions, eg. StringOnlyASCIIAll, with its byte offset Index and rune
+// offset RuneIndex in the value.
+type InvalidChar struct {
+	Char      string
+	Index     int
+	RuneIndex int
+}
+
+// StringComparison is how strings are compared by the *Mode family of
+// functions. Modes are combined with |, eg. CompareFold|CompareNFC. The zero
+// StringComparison compares strings byte-wise.
+type StringComparison int
+
+const (
+	// CompareFold compares strings case-insensitively with full Unicode case
+	// folding, eg. Straße equals STRASSE.
+	CompareFold StringComparison = 1 << iota
+
+	// CompareNFC compares strings in Unicode normalization form NFC, so that
+	// composed and decomposed accents are equal, eg. café in NFC and NFD.
+	CompareNFC
+
+	// CompareNFKC compares strings in Unicode normalization form NFKC, which
+	// also equates compatibility characters, eg. ﬁ and fi, or ２ and 2.
+	CompareNFKC
 )
 
+// strNormalizationForms maps the names of Unicode normalization forms accepted
+// by StringNormalized to their norm.Form.
+var strNormalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
 // StringNotEmpty returns error if value=="", otherwise nil.
 func StringNotEmpty(field, value string) *ErrValidation {
 	if value == "" {
@@ -191,6 +239,29 @@ func StringOnlyNumeric(field, value string) *ErrValidation {
 	return nil
 }
 
+// StringOnlyASCIIAll is like StringOnlyASCII, but reports all non-ASCII
+// characters in Args, up to max of them if max>0. See InvalidChar.
+func StringOnlyASCIIAll(field, value string, max int) *ErrValidation {
+	return strOnlyAll(field, value, max, func(c rune) bool {
+		return c <= unicode.MaxASCII
+	}, strOnlyASCIIErrorCode, fmt.Sprintf(strOnlyASCIIErrorMessage, field))
+}
+
+// StringOnlyAlphanumericAll is like StringOnlyAlphanumeric, but reports all
+// non-alphanumeric characters in Args, up to max of them if max>0. See
+// InvalidChar.
+func StringOnlyAlphanumericAll(field, value string, max int) *ErrValidation {
+	return strOnlyAll(field, value, max, func(c rune) bool {
+		return unicode.IsDigit(c) || unicode.IsLetter(c)
+	}, strOnlyAlphanumericErrorCode, fmt.Sprintf(strOnlyAlphanumericErrorMessage, field))
+}
+
+// StringOnlyNumericAll is like StringOnlyNumeric, but reports all non-numeric
+// characters in Args, up to max of them if max>0. See InvalidChar.
+func StringOnlyNumericAll(field, value string, max int) *ErrValidation {
+	return strOnlyAll(field, value, max, unicode.IsDigit, strOnlyNumericErrorCode, fmt.Sprintf(strOnlyNumericErrorMessage, field))
+}
+
 // StringIn returns error if value has no match in values, otherwise nil.
 // Comparison is done case-sensitively.
 func StringIn(field, value string, values []string) *ErrValidation {
@@ -208,19 +279,10 @@ func StringIn(field, value string, values []string) *ErrValidation {
 }
 
 // StringInIgnoreCase returns error if value has no match in values, otherwise
-// nil. Comparison is done case-insensitively.
+// nil. Comparison is done case-insensitively, with full Unicode case folding,
+// in normalization form NFC. See CompareFold and CompareNFC.
 func StringInIgnoreCase(field, value string, values []string) *ErrValidation {
-	for _, v := range values {
-		if strings.ToLower(v) == strings.ToLower(value) {
-			return nil
-		}
-	}
-
-	args := struct{}{}
-	code := fmt.Sprintf(strErrorCode, strInErrorCode)
-	message := fmt.Sprintf(strInErrorMessage, field, values)
-
-	return NewError(code, args, message, field, value)
+	return StringInMode(field, value, values, CompareFold|CompareNFC)
 }
 
 // StringNoDuplicate returns error if values contain duplicated value,
@@ -248,14 +310,122 @@ func StringNoDuplicate(field string, values []string) *ErrValidation {
 }
 
 // StringNoDuplicateIgnoreCase returns error if values contain duplicated
-// value, otherwise nil. Comparison is done case-insensitively.
+// value, otherwise nil. Comparison is done case-insensitively, with full
+// Unicode case folding, in normalization form NFC. See CompareFold and
+// CompareNFC.
 func StringNoDuplicateIgnoreCase(field string, values []string) *ErrValidation {
+	return StringNoDuplicateMode(field, values, CompareFold|CompareNFC)
+}
+
+// StringEach calls fn for every element of values, with the index of the
+// element appended to field, eg. tags[2]. StringEach returns the first error
+// returned by fn, otherwise nil.
+func StringEach(field string, values []string, fn func(field, value string) *ErrValidation) *ErrValidation {
+	for i, v := range values {
+		if err := fn(sliceField(field, i), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StringSubsetOf returns error if any of values has no match in allowed,
+// otherwise nil. Comparison is done case-sensitively.
+func StringSubsetOf(field string, values, allowed []string) *ErrValidation {
 	m := make(map[string]struct{})
 
+	for _, v := range allowed {
+		m[v] = struct{}{}
+	}
+
+	for i, v := range values {
+		if _, ok := m[v]; !ok {
+			args := struct {
+				Index int
+				Found string
+			}{
+				i, v,
+			}
+			code := fmt.Sprintf(strErrorCode, strSubsetOfErrorCode)
+			message := fmt.Sprintf(strSubsetOfErrorMessage, field, allowed)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// StringSubsetOfIgnoreCase returns error if any of values has no match in
+// allowed, otherwise nil. Comparison is done case-insensitively, with full
+// Unicode case folding, in normalization form NFC. See CompareFold and
+// CompareNFC.
+func StringSubsetOfIgnoreCase(field string, values, allowed []string) *ErrValidation {
+	return StringSubsetOfMode(field, values, allowed, CompareFold|CompareNFC)
+}
+
+// StringContainsAll returns error if any of required has no match in values,
+// otherwise nil. Comparison is done case-sensitively.
+func StringContainsAll(field string, values, required []string) *ErrValidation {
+	m := make(map[string]struct{})
+
+	for _, v := range values {
+		m[v] = struct{}{}
+	}
+
+	for _, v := range required {
+		if _, ok := m[v]; !ok {
+			args := struct {
+				Missing string
+			}{
+				v,
+			}
+			code := fmt.Sprintf(strErrorCode, strContainsAllErrorCode)
+			message := fmt.Sprintf(strContainsAllErrorMessage, field, required)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// StringContainsAllIgnoreCase returns error if any of required has no match in
+// values, otherwise nil. Comparison is done case-insensitively, with full
+// Unicode case folding, in normalization form NFC. See CompareFold and
+// CompareNFC.
+func StringContainsAllIgnoreCase(field string, values, required []string) *ErrValidation {
+	return StringContainsAllMode(field, values, required, CompareFold|CompareNFC)
+}
+
+// StringInMode returns error if value has no match in values, otherwise nil.
+// Comparison is done according to mode.
+func StringInMode(field, value string, values []string, mode StringComparison) *ErrValidation {
+	k := strKey(value, mode)
+
 	for _, v := range values {
-		w := strings.ToLower(v)
+		if strKey(v, mode) == k {
+			return nil
+		}
+	}
+
+	args := struct{}{}
+	code := fmt.Sprintf(strErrorCode, strInErrorCode)
+	message := fmt.Sprintf(strInErrorMessage, field, values)
 
-		if _, ok := m[w]; ok {
+	return NewError(code, args, message, field, value)
+}
+
+// StringNoDuplicateMode returns error if values contain duplicated value,
+// otherwise nil. Comparison is done according to mode.
+func StringNoDuplicateMode(field string, values []string, mode StringComparison) *ErrValidation {
+	m := make(map[string]struct{})
+
+	for _, v := range values {
+		k := strKey(v, mode)
+
+		if _, ok := m[k]; ok {
 			args := struct {
 				Found string
 			}{
@@ -267,8 +437,189 @@ func StringNoDuplicateIgnoreCase(field string, values []string) *ErrValidation {
 			return NewError(code, args, message, field, nil)
 		}
 
-		m[w] = struct{}{}
+		m[k] = struct{}{}
+	}
+
+	return nil
+}
+
+// StringSubsetOfMode returns error if any of values has no match in allowed,
+// otherwise nil. Comparison is done according to mode.
+func StringSubsetOfMode(field string, values, allowed []string, mode StringComparison) *ErrValidation {
+	m := make(map[string]struct{})
+
+	for _, v := range allowed {
+		m[strKey(v, mode)] = struct{}{}
+	}
+
+	for i, v := range values {
+		if _, ok := m[strKey(v, mode)]; !ok {
+			args := struct {
+				Index int
+				Found string
+			}{
+				i, v,
+			}
+			code := fmt.Sprintf(strErrorCode, strSubsetOfErrorCode)
+			message := fmt.Sprintf(strSubsetOfErrorMessage, field, allowed)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// StringContainsAllMode returns error if any of required has no match in
+// values, otherwise nil. Comparison is done according to mode.
+func StringContainsAllMode(field string, values, required []string, mode StringComparison) *ErrValidation {
+	m := make(map[string]struct{})
+
+	for _, v := range values {
+		m[strKey(v, mode)] = struct{}{}
+	}
+
+	for _, v := range required {
+		if _, ok := m[strKey(v, mode)]; !ok {
+			args := struct {
+				Missing string
+			}{
+				v,
+			}
+			code := fmt.Sprintf(strErrorCode, strContainsAllErrorCode)
+			message := fmt.Sprintf(strContainsAllErrorMessage, field, required)
+
+			return NewError(code, args, message, field, nil)
+		}
 	}
 
 	return nil
 }
+
+// StringNormalized returns error if value is not in the Unicode normalization
+// form, one of NFC, NFD, NFKC or NFKD, otherwise nil. StringNormalized panics
+// if form is unknown.
+func StringNormalized(field, value, form string) *ErrValidation {
+	f, ok := strNormalizationForms[form]
+
+	if !ok {
+		panic("unknown normalization form " + form)
+	}
+
+	if !f.IsNormalString(value) {
+		args := struct {
+			Form  string
+			Index int
+		}{
+			form, f.QuickSpanString(value),
+		}
+		code := fmt.Sprintf(strErrorCode, strNormalizedErrorCode)
+		message := fmt.Sprintf(strNormalizedErrorMessage, field, form)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringSorted returns error if values is not sorted in ascending order,
+// otherwise nil. Comparison is done case-sensitively, byte-wise.
+func StringSorted(field string, values []string) *ErrValidation {
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[i-1] {
+			args := struct {
+				Index int
+				Found string
+			}{
+				i, values[i],
+			}
+			code := fmt.Sprintf(strErrorCode, strSortedErrorCode)
+			message := fmt.Sprintf(strSortedErrorMessage, field)
+
+			return NewError(code, args, message, field, nil)
+		}
+	}
+
+	return nil
+}
+
+// strOnlyAll returns error with code and message if any character of value is
+// not valid, with the first max invalid characters, or all if max<=0, and the
+// number of invalid characters in Args.
+func strOnlyAll(field, value string, max int, valid func(rune) bool, code, message string) *ErrValidation {
+	var chars []InvalidChar
+	count, runeIndex := 0, 0
+
+	for i, c := range value {
+		if !valid(c) {
+			if max <= 0 || count < max {
+				chars = append(chars, InvalidChar{string(c), i, runeIndex})
+			}
+
+			count++
+		}
+
+		runeIndex++
+	}
+
+	if count > 0 {
+		args := struct {
+			Chars []InvalidChar
+			Count int
+		}{
+			chars, count,
+		}
+		code = fmt.Sprintf(strErrorCode, code)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// strDigits reports whether s is made up of ASCII digits only.
+func strDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// strKey returns value transformed according to mode, so that strings equal
+// under mode have equal keys. Case folding may leave a string unnormalized,
+// so normalization is applied again after folding.
+func strKey(value string, mode StringComparison) string {
+	f, normalize := norm.NFC, mode&(CompareNFC|CompareNFKC) != 0
+
+	if mode&CompareNFKC != 0 {
+		f = norm.NFKC
+	}
+
+	if normalize {
+		value = f.String(value)
+	}
+
+	if mode&CompareFold != 0 {
+		value = cases.Fold().String(value)
+
+		if normalize {
+			value = f.String(value)
+		}
+	}
+
+	return value
+}
+
+// strContains reports whether values contains value.
+func strContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringOnlyAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   *ErrValidation
+		chars []InvalidChar
+		count int
+	}{
+		{"StringOnlyASCIIAll", StringOnlyASCIIAll("f", "abc", 0), nil, 0},
+		{"StringOnlyASCIIAll", StringOnlyASCIIAll("f", "héllo wörld", 0), []InvalidChar{{"é", 1, 1}, {"ö", 8, 7}}, 2},
+		{"StringOnlyASCIIAll", StringOnlyASCIIAll("f", "héllo wörld", 1), []InvalidChar{{"é", 1, 1}}, 2},
+		{"StringOnlyAlphanumericAll", StringOnlyAlphanumericAll("f", "a-b c", 0), []InvalidChar{{"-", 1, 1}, {" ", 3, 3}}, 2},
+		{"StringOnlyNumericAll", StringOnlyNumericAll("f", "12a4b", 0), []InvalidChar{{"a", 2, 2}, {"b", 4, 4}}, 2},
+		{"StringOnlyCharsetAll", StringOnlyCharsetAll("f", "dead-beef!", CharsetHex, 0), []InvalidChar{{"-", 4, 4}, {"!", 9, 9}}, 2},
+		{"StringOnlyCharsetAll", StringOnlyCharsetAll("f", "cafe", CharsetHex, 0), nil, 0},
+	}
+
+	for _, tt := range tests {
+		if tt.count == 0 {
+			if tt.err != nil {
+				t.Errorf("%v() = %v, want nil", tt.name, tt.err)
+			}
+
+			continue
+		}
+
+		if tt.err == nil {
+			t.Errorf("%v() = nil, want error", tt.name)
+
+			continue
+		}
+
+		args, ok := tt.err.Args.(struct {
+			Chars []InvalidChar
+			Count int
+		})
+
+		if !ok || !reflect.DeepEqual(args.Chars, tt.chars) || args.Count != tt.count {
+			t.Errorf("%v() Args = %+v, want {Chars:%+v Count:%v}", tt.name, tt.err.Args, tt.chars, tt.count)
+		}
+	}
+
+	if code := errCode(StringOnlyASCIIAll("f", "é", 0)); code != "ERROR_STRING_ONLY_ASCII" {
+		t.Errorf("StringOnlyASCIIAll(%q) = %q, want %q", "é", code, "ERROR_STRING_ONLY_ASCII")
+	}
+
+	if code := errCode(StringOnlyCharsetAll("f", "g", CharsetHex, 0)); code != "ERROR_STRING_ONLY_CHARSET" {
+		t.Errorf("StringOnlyCharsetAll(%q) = %q, want %q", "g", code, "ERROR_STRING_ONLY_CHARSET")
+	}
+}
+
+func TestStringComparison(t *testing.T) {
+	tests := []struct {
+		value  string
+		values []string
+		mode   StringComparison
+		code   string
+	}{
+		{"Straße", []string{"STRASSE"}, 0, "ERROR_STRING_IN"},
+		{"Straße", []string{"STRASSE"}, CompareFold, ""},
+		{"ß", []string{"SS"}, CompareFold, ""},
+		{"cafe\u0301", []string{"café"}, 0, "ERROR_STRING_IN"},
+		{"cafe\u0301", []string{"café"}, CompareNFC, ""},
+		{"CAFE\u0301", []string{"café"}, CompareFold, "ERROR_STRING_IN"},
+		{"CAFE\u0301", []string{"café"}, CompareFold | CompareNFC, ""},
+		{"ﬁle", []string{"file"}, CompareNFC, "ERROR_STRING_IN"},
+		{"ﬁle", []string{"file"}, CompareNFKC, ""},
+		{"２", []string{"2"}, CompareNFKC, ""},
+		{"ＦＩＬＥ", []string{"file"}, CompareFold | CompareNFKC, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringInMode("f", tt.value, tt.values, tt.mode)); code != tt.code {
+			t.Errorf("StringInMode(%q, %q, %v) = %q, want %q", tt.value, tt.values, tt.mode, code, tt.code)
+		}
+	}
+}
+
+func TestStringIgnoreCase(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ErrValidation
+		code string
+	}{
+		{"StringInIgnoreCase", StringInIgnoreCase("f", "cafe\u0301", []string{"café"}), ""},
+		{"StringInIgnoreCase", StringInIgnoreCase("f", "STRASSE", []string{"straße"}), ""},
+		{"StringInIgnoreCase", StringInIgnoreCase("f", "cafe", []string{"café"}), "ERROR_STRING_IN"},
+		{"StringNoDuplicateIgnoreCase", StringNoDuplicateIgnoreCase("f", []string{"café", "CAFE\u0301"}), "ERROR_STRING_NO_DUPLICATE"},
+		{"StringNoDuplicateIgnoreCase", StringNoDuplicateIgnoreCase("f", []string{"Straße", "STRASSE"}), "ERROR_STRING_NO_DUPLICATE"},
+		{"StringNoDuplicateIgnoreCase", StringNoDuplicateIgnoreCase("f", []string{"café", "cafe"}), ""},
+		{"StringSubsetOfIgnoreCase", StringSubsetOfIgnoreCase("f", []string{"CAFE\u0301"}, []string{"café"}), ""},
+		{"StringContainsAllIgnoreCase", StringContainsAllIgnoreCase("f", []string{"cafe\u0301"}, []string{"CAFÉ"}), ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.err); code != tt.code {
+			t.Errorf("%v() = %q, want %q", tt.name, code, tt.code)
+		}
+	}
+}
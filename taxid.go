@@ -0,0 +1,225 @@
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	strTaxIDErrorCode = "TAX_ID"
+)
+
+const (
+	strTaxIDErrorMessage = "%v is not a tax identification number of %v"
+)
+
+// taxIDVATFormats maps EU member states to the format of their VAT
+// identification numbers, without the country prefix, and the function
+// verifying the check digits, if any.
+var taxIDVATFormats = map[string]struct {
+	prefix   string
+	pattern  *regexp.Regexp
+	checksum func(string) bool
+}{
+	"AT": {"AT", regexp.MustCompile(`^U\d{8}$`), taxIDChecksumAT},
+	"BE": {"BE", regexp.MustCompile(`^[01]\d{9}$`), taxIDChecksumBE},
+	"BG": {"BG", regexp.MustCompile(`^\d{9,10}$`), nil},
+	"CY": {"CY", regexp.MustCompile(`^\d{8}[A-Z]$`), nil},
+	"CZ": {"CZ", regexp.MustCompile(`^\d{8,10}$`), nil},
+	"DE": {"DE", regexp.MustCompile(`^\d{9}$`), taxIDChecksumDE},
+	"DK": {"DK", regexp.MustCompile(`^\d{8}$`), taxIDChecksumDK},
+	"EE": {"EE", regexp.MustCompile(`^\d{9}$`), nil},
+	"ES": {"ES", regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`), nil},
+	"FI": {"FI", regexp.MustCompile(`^\d{8}$`), taxIDChecksumFI},
+	"FR": {"FR", regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}\d{9}$`), taxIDChecksumFR},
+	"GR": {"EL", regexp.MustCompile(`^\d{9}$`), nil},
+	"HR": {"HR", regexp.MustCompile(`^\d{11}$`), taxIDChecksumHR},
+	"HU": {"HU", regexp.MustCompile(`^\d{8}$`), nil},
+	"IE": {"IE", regexp.MustCompile(`^\d[A-Z0-9+*]\d{5}[A-Z]{1,2}$`), nil},
+	"IT": {"IT", regexp.MustCompile(`^\d{11}$`), cardLuhn},
+	"LT": {"LT", regexp.MustCompile(`^(\d{9}|\d{12})$`), nil},
+	"LU": {"LU", regexp.MustCompile(`^\d{8}$`), taxIDChecksumLU},
+	"LV": {"LV", regexp.MustCompile(`^\d{11}$`), nil},
+	"MT": {"MT", regexp.MustCompile(`^\d{8}$`), nil},
+	"NL": {"NL", regexp.MustCompile(`^\d{9}B\d{2}$`), taxIDChecksumNL},
+	"PL": {"PL", regexp.MustCompile(`^\d{10}$`), taxIDChecksumPL},
+	"PT": {"PT", regexp.MustCompile(`^\d{9}$`), taxIDChecksumPT},
+	"RO": {"RO", regexp.MustCompile(`^[1-9]\d{1,9}$`), nil},
+	"SE": {"SE", regexp.MustCompile(`^\d{10}01$`), taxIDChecksumSE},
+	"SI": {"SI", regexp.MustCompile(`^\d{8}$`), nil},
+	"SK": {"SK", regexp.MustCompile(`^\d{10}$`), taxIDChecksumSK},
+}
+
+var taxIDRules = &countryRules{rules: taxIDVATRules()}
+
+// RegisterTaxIDRule sets the rule used by StringTaxID to check tax
+// identification numbers of country, an ISO 3166-1 alpha-2 code, replacing any
+// existing rule. RegisterTaxIDRule is safe for concurrent use.
+func RegisterTaxIDRule(country string, rule CountryRule) {
+	taxIDRules.set(country, rule)
+}
+
+// StringTaxID returns error if value is not a tax identification number of
+// country, an ISO 3166-1 alpha-2 code, otherwise nil. Spaces and hyphens are
+// ignored. Rules are built in for the VAT identification numbers of EU member
+// states, with or without the country prefix, eg. DE136695976, checking the
+// check digits where the algorithm is public. Rules can be added with
+// RegisterTaxIDRule. Countries without a rule are rejected.
+func StringTaxID(field, value, country string) *ErrValidation {
+	return countryCheck(field, value, country, taxIDRules, strTaxIDErrorCode, strTaxIDErrorMessage)
+}
+
+// taxIDVATRules returns the rules of the VAT identification numbers of EU
+// member states.
+func taxIDVATRules() map[string]CountryRule {
+	rules := make(map[string]CountryRule)
+
+	for country, format := range taxIDVATFormats {
+		format := format
+
+		rules[country] = func(value string) string {
+			value = strings.TrimPrefix(value, format.prefix)
+
+			if !format.pattern.MatchString(value) {
+				return countryComponentFormat
+			}
+
+			if format.checksum != nil && !format.checksum(value) {
+				return countryComponentChecksum
+			}
+
+			return ""
+		}
+	}
+
+	return rules
+}
+
+// taxIDWeightedSum returns the sum of the digits of s multiplied by weights.
+func taxIDWeightedSum(s string, weights []int) int {
+	sum := 0
+
+	for i, w := range weights {
+		sum += int(s[i]-'0') * w
+	}
+
+	return sum
+}
+
+// taxIDMod1110 reports whether the last digit of s is the ISO 7064 MOD 11,10
+// check digit of the other digits.
+func taxIDMod1110(s string) bool {
+	p := 10
+
+	for i := 0; i < len(s)-1; i++ {
+		d := (int(s[i]-'0') + p) % 10
+
+		if d == 0 {
+			d = 10
+		}
+
+		p = d * 2 % 11
+	}
+
+	return (11-p)%10 == int(s[len(s)-1]-'0')
+}
+
+func taxIDChecksumAT(s string) bool {
+	sum := 0
+
+	for i := 1; i < 8; i++ {
+		d := int(s[i] - '0')
+
+		if i%2 == 0 {
+			d = d*2/10 + d*2%10
+		}
+
+		sum += d
+	}
+
+	return (96-sum)%10 == int(s[8]-'0')
+}
+
+func taxIDChecksumBE(s string) bool {
+	n, _ := strconv.Atoi(s[:8])
+	c, _ := strconv.Atoi(s[8:])
+
+	return 97-n%97 == c
+}
+
+func taxIDChecksumDE(s string) bool {
+	return taxIDMod1110(s)
+}
+
+func taxIDChecksumDK(s string) bool {
+	return taxIDWeightedSum(s, []int{2, 7, 6, 5, 4, 3, 2, 1})%11 == 0
+}
+
+func taxIDChecksumFI(s string) bool {
+	r := taxIDWeightedSum(s, []int{7, 9, 10, 5, 8, 4, 2}) % 11
+
+	if r == 1 {
+		return false
+	}
+
+	return (11-r)%11 == int(s[7]-'0')
+}
+
+// taxIDChecksumFR checks the 2-character key of French VAT numbers. Only
+// numeric keys have a public algorithm, so other keys are accepted.
+func taxIDChecksumFR(s string) bool {
+	if !strDigits(s[:2]) {
+		return true
+	}
+
+	key, _ := strconv.Atoi(s[:2])
+	siren, _ := strconv.Atoi(s[2:])
+
+	return (12+3*(siren%97))%97 == key
+}
+
+func taxIDChecksumHR(s string) bool {
+	return taxIDMod1110(s)
+}
+
+func taxIDChecksumLU(s string) bool {
+	n, _ := strconv.Atoi(s[:6])
+	c, _ := strconv.Atoi(s[6:])
+
+	return n%89 == c
+}
+
+// taxIDChecksumNL checks Dutch VAT numbers with either the mod 11 check of
+// the older numbers based on RSIN, or the mod 97 check of the newer numbers.
+func taxIDChecksumNL(s string) bool {
+	sum := taxIDWeightedSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2}) - int(s[8]-'0')
+
+	return sum%11 == 0 || bankMod97("NL"+s)
+}
+
+func taxIDChecksumPL(s string) bool {
+	r := taxIDWeightedSum(s, []int{6, 5, 7, 2, 3, 4, 5, 6, 7}) % 11
+
+	return r != 10 && r == int(s[9]-'0')
+}
+
+func taxIDChecksumPT(s string) bool {
+	c := 11 - taxIDWeightedSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2})%11
+
+	if c >= 10 {
+		c = 0
+	}
+
+	return c == int(s[8]-'0')
+}
+
+func taxIDChecksumSE(s string) bool {
+	return cardLuhn(s[:10])
+}
+
+func taxIDChecksumSK(s string) bool {
+	n, _ := strconv.ParseInt(s, 10, 64)
+
+	return n%11 == 0
+}
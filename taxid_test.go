@@ -0,0 +1,77 @@
+package validation
+
+import "testing"
+
+func TestStringTaxID(t *testing.T) {
+	tests := []struct {
+		value, country string
+		component      string
+	}{
+		// Valid VAT identification numbers as used in the examples of
+		// national tax administrations and python-stdnum.
+		{"ATU13585627", "AT", ""},
+		{"U13585627", "AT", ""},
+		{"ATU13585626", "AT", "checksum"},
+		{"BE0403019261", "BE", ""},
+		{"BE0403019262", "BE", "checksum"},
+		{"BE2403019261", "BE", "format"},
+		{"DE136695976", "DE", ""},
+		{"136 695 976", "DE", ""},
+		{"DE136695978", "DE", "checksum"},
+		{"DE13669597", "DE", "format"},
+		{"DK13585628", "DK", ""},
+		{"DK13585627", "DK", "checksum"},
+		{"FI20774740", "FI", ""},
+		{"FI20774741", "FI", "checksum"},
+		{"FR40303265045", "FR", ""},
+		{"FR41303265045", "FR", "checksum"},
+		{"FRK7399859412", "FR", ""},
+		{"FRI7399859412", "FR", "format"},
+		{"HR33392005961", "HR", ""},
+		{"HR33392005962", "HR", "checksum"},
+		{"IT00743110157", "IT", ""},
+		{"IT00743110158", "IT", "checksum"},
+		{"LU15027442", "LU", ""},
+		{"LU15027443", "LU", "checksum"},
+		{"NL004495445B01", "NL", ""},
+		{"NL000099998B57", "NL", ""},
+		{"NL004495446B01", "NL", "checksum"},
+		{"NL004495445X01", "NL", "format"},
+		{"PL8567346215", "PL", ""},
+		{"PL8567346216", "PL", "checksum"},
+		{"PT501964843", "PT", ""},
+		{"PT501964842", "PT", "checksum"},
+		{"SE123456789701", "SE", ""},
+		{"SE123456789101", "SE", "checksum"},
+		{"SE123456789702", "SE", "format"},
+		{"SK2022749619", "SK", ""},
+		{"SK2022749618", "SK", "checksum"},
+		{"EL094259216", "GR", ""},
+		{"GR094259216", "GR", "format"},
+		{"DE136695976", "US", "country"},
+	}
+
+	for _, tt := range tests {
+		if component := countryComponent(StringTaxID("f", tt.value, tt.country)); component != tt.component {
+			t.Errorf("StringTaxID(%q, %q) component = %q, want %q", tt.value, tt.country, component, tt.component)
+		}
+	}
+}
+
+func TestTaxIDMod1110(t *testing.T) {
+	tests := []struct {
+		s     string
+		valid bool
+	}{
+		{"136695976", true},
+		{"33392005961", true},
+		{"136695978", false},
+		{"33392005962", false},
+	}
+
+	for _, tt := range tests {
+		if valid := taxIDMod1110(tt.s); valid != tt.valid {
+			t.Errorf("taxIDMod1110(%q) = %v, want %v", tt.s, valid, tt.valid)
+		}
+	}
+}
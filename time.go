@@ -0,0 +1,326 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	timeErrorCode = "ERROR_TIME_%v"
+)
+
+const (
+	timeFormatErrorCode      = "FORMAT"
+	timeRFC3339ErrorCode     = "RFC3339"
+	timeISO8601ErrorCode     = "ISO8601"
+	timeBeforeErrorCode      = "BEFORE"
+	timeAfterErrorCode       = "AFTER"
+	timeBetweenErrorCode     = "BETWEEN"
+	timeNotInFutureErrorCode = "NOT_IN_FUTURE"
+	timeMinAgeErrorCode      = "MIN_AGE"
+	timeWeekdayErrorCode     = "WEEKDAY"
+	timeBusinessDayErrorCode = "BUSINESS_DAY"
+	timeZoneErrorCode        = "ZONE"
+)
+
+const (
+	timeFormatErrorMessage      = "%v does not conform with the layout %v"
+	timeRFC3339ErrorMessage     = "%v is not an RFC 3339 date-time"
+	timeISO8601ErrorMessage     = "%v is not an ISO 8601 date or date-time"
+	timeBeforeErrorMessage      = "%v is not before %v"
+	timeAfterErrorMessage       = "%v is not after %v"
+	timeBetweenErrorMessage     = "%v is not between %v and %v"
+	timeNotInFutureErrorMessage = "%v is in the future"
+	timeMinAgeErrorMessage      = "%v is less than %v year(s) ago"
+	timeWeekdayErrorMessage     = "%v does not fall on %v"
+	timeBusinessDayErrorMessage = "%v does not fall on a business day"
+	timeZoneErrorMessage        = "%v is not a valid time zone"
+)
+
+// timeISO8601Layouts are the ISO 8601 extended formats accepted by
+// TimeISO8601.
+var timeISO8601Layouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// TimeFormat returns error if value cannot be parsed with layout, otherwise
+// nil. See time.Parse for the syntax of layout.
+func TimeFormat(field, value, layout string) *ErrValidation {
+	_, err := timeParse(field, value, layout)
+
+	return err
+}
+
+// TimeRFC3339 returns error if value is not an RFC 3339 date-time, eg.
+// 2006-01-02T15:04:05Z, otherwise nil.
+func TimeRFC3339(field, value string) *ErrValidation {
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		args := struct{}{}
+		code := fmt.Sprintf(timeErrorCode, timeRFC3339ErrorCode)
+		message := fmt.Sprintf(timeRFC3339ErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeISO8601 returns error if value is not an ISO 8601 date or date-time in
+// the extended format, eg. 2006-01-02 or 2006-01-02T15:04:05+08:00, otherwise
+// nil.
+func TimeISO8601(field, value string) *ErrValidation {
+	for _, layout := range timeISO8601Layouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return nil
+		}
+	}
+
+	args := struct{}{}
+	code := fmt.Sprintf(timeErrorCode, timeISO8601ErrorCode)
+	message := fmt.Sprintf(timeISO8601ErrorMessage, field)
+
+	return NewError(code, args, message, field, value)
+}
+
+// TimeBefore returns error if value, parsed with layout, is not before before,
+// otherwise nil.
+func TimeBefore(field, value, layout string, before time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	if !t.Before(before) {
+		args := struct {
+			Before time.Time
+		}{
+			before,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeBeforeErrorCode)
+		message := fmt.Sprintf(timeBeforeErrorMessage, field, before.Format(layout))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeAfter returns error if value, parsed with layout, is not after after,
+// otherwise nil.
+func TimeAfter(field, value, layout string, after time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	if !t.After(after) {
+		args := struct {
+			After time.Time
+		}{
+			after,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeAfterErrorCode)
+		message := fmt.Sprintf(timeAfterErrorMessage, field, after.Format(layout))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeBetween returns error if value, parsed with layout, is before min or
+// after max, otherwise nil.
+func TimeBetween(field, value, layout string, min, max time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	if t.Before(min) || t.After(max) {
+		args := struct {
+			Min, Max time.Time
+		}{
+			min, max,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeBetweenErrorCode)
+		message := fmt.Sprintf(timeBetweenErrorMessage, field, min.Format(layout), max.Format(layout))
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeNotInFuture returns error if value, parsed with layout, is after now,
+// otherwise nil. now is usually time.Now().
+func TimeNotInFuture(field, value, layout string, now time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	if t.After(now) {
+		args := struct {
+			Now time.Time
+		}{
+			now,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeNotInFutureErrorCode)
+		message := fmt.Sprintf(timeNotInFutureErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeMinAge returns error if the date of value, parsed with layout, is less
+// than years years before the date of now, otherwise nil. Only calendar dates
+// are compared, each in its own time zone, so a value without a zone is not
+// shifted into the zone of now. now is usually time.Now(). eg. TimeMinAge with
+// years=18 checks if a birth date is at least 18 years ago, and a person born
+// on 29 February turns 18 on 1 March.
+func TimeMinAge(field, value, layout string, years int, now time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	y, m, d := now.Date()
+
+	// 29 February of now would normalise to 1 March in a year that is not a
+	// leap year, so the latest date is 28 February instead.
+	if m == time.February && d == 29 && time.Date(y-years, m, d, 0, 0, 0, 0, time.UTC).Month() != m {
+		d = 28
+	}
+
+	latest := time.Date(y-years, m, d, 0, 0, 0, 0, time.UTC)
+
+	if y, m, d = t.Date(); time.Date(y, m, d, 0, 0, 0, 0, time.UTC).After(latest) {
+		args := struct {
+			Years  int
+			Latest time.Time
+		}{
+			years, latest,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeMinAgeErrorCode)
+		message := fmt.Sprintf(timeMinAgeErrorMessage, field, years)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// TimeWeekday returns error if value, parsed with layout, does not fall on one
+// of weekdays, otherwise nil.
+func TimeWeekday(field, value, layout string, weekdays []time.Weekday) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	for _, d := range weekdays {
+		if t.Weekday() == d {
+			return nil
+		}
+	}
+
+	args := struct {
+		Weekday  time.Weekday
+		Weekdays []time.Weekday
+	}{
+		t.Weekday(), weekdays,
+	}
+	code := fmt.Sprintf(timeErrorCode, timeWeekdayErrorCode)
+	message := fmt.Sprintf(timeWeekdayErrorMessage, field, weekdays)
+
+	return NewError(code, args, message, field, value)
+}
+
+// TimeBusinessDay returns error if value, parsed with layout, falls on a
+// Saturday, a Sunday or the same date as any of holidays, otherwise nil.
+func TimeBusinessDay(field, value, layout string, holidays []time.Time) *ErrValidation {
+	t, err := timeParse(field, value, layout)
+
+	if err != nil {
+		return err
+	}
+
+	args := struct {
+		Weekday time.Weekday
+		Holiday bool
+	}{
+		t.Weekday(), false,
+	}
+	code := fmt.Sprintf(timeErrorCode, timeBusinessDayErrorCode)
+	message := fmt.Sprintf(timeBusinessDayErrorMessage, field)
+
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return NewError(code, args, message, field, value)
+	}
+
+	y1, m1, d1 := t.Date()
+
+	for _, h := range holidays {
+		y2, m2, d2 := h.Date()
+
+		if y1 == y2 && m1 == m2 && d1 == d2 {
+			args.Holiday = true
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// TimeZone returns error if value is not a time zone name found in the IANA
+// Time Zone database, eg. Asia/Kuala_Lumpur, otherwise nil. "" and "Local"
+// are rejected.
+func TimeZone(field, value string) *ErrValidation {
+	_, err := time.LoadLocation(value)
+
+	if err != nil || value == "" || strings.EqualFold(value, "Local") {
+		args := struct{}{}
+		code := fmt.Sprintf(timeErrorCode, timeZoneErrorCode)
+		message := fmt.Sprintf(timeZoneErrorMessage, field)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// timeParse parses value with layout, and returns error if value cannot be
+// parsed.
+func timeParse(field, value, layout string) (time.Time, *ErrValidation) {
+	t, err := time.Parse(layout, value)
+
+	if err != nil {
+		args := struct {
+			Layout string
+		}{
+			layout,
+		}
+		code := fmt.Sprintf(timeErrorCode, timeFormatErrorCode)
+		message := fmt.Sprintf(timeFormatErrorMessage, field, layout)
+
+		return t, NewError(code, args, message, field, value)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeMinAge(t *testing.T) {
+	utc8 := time.FixedZone("UTC+8", 8*60*60)
+	tests := []struct {
+		value, layout string
+		now           time.Time
+		code          string
+	}{
+		{"2008-10-19", "2006-01-02", time.Date(2026, 10, 19, 1, 0, 0, 0, utc8), ""},
+		{"2008-10-19", "2006-01-02", time.Date(2026, 10, 19, 23, 59, 0, 0, time.UTC), ""},
+		{"2008-10-20", "2006-01-02", time.Date(2026, 10, 19, 23, 59, 0, 0, utc8), "ERROR_TIME_MIN_AGE"},
+		{"2008-10-19T23:00:00Z", time.RFC3339, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), ""},
+		{"2008-02-29", "2006-01-02", time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC), "ERROR_TIME_MIN_AGE"},
+		{"2008-02-29", "2006-01-02", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), ""},
+		{"2010-02-28", "2006-01-02", time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC), ""},
+		{"2010-03-01", "2006-01-02", time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC), "ERROR_TIME_MIN_AGE"},
+		{"19-10-2008", "2006-01-02", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), "ERROR_TIME_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		err := TimeMinAge("f", tt.value, tt.layout, 18, tt.now)
+
+		if code := errCode(err); code != tt.code {
+			t.Errorf("TimeMinAge(%q, %v) = %v, want %q", tt.value, tt.now, err, tt.code)
+		}
+	}
+}
+
+func TestTimeNotInFuture(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	if err := TimeNotInFuture("f", "2026-10-19T12:00:00Z", time.RFC3339, now); err != nil {
+		t.Errorf("TimeNotInFuture(now) = %v, want nil", err)
+	}
+
+	if err := TimeNotInFuture("f", "2026-10-19T12:00:01Z", time.RFC3339, now); errCode(err) != "ERROR_TIME_NOT_IN_FUTURE" {
+		t.Errorf("TimeNotInFuture(now+1s) = %v, want ERROR_TIME_NOT_IN_FUTURE", err)
+	}
+}
@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Transform is a named transformation of a string applied by StringTransform
+// before validation, eg. TransformTrim.
+type Transform struct {
+	Name string
+	Func func(value string) string
+}
+
+var (
+	// TransformTrim removes leading and trailing white space.
+	TransformTrim = Transform{"trim", strings.TrimSpace}
+
+	// TransformCollapseSpace replaces each run of white space with a single
+	// space.
+	TransformCollapseSpace = Transform{"collapse_space", transformCollapseSpace}
+
+	// TransformLower converts to lowercase with the Unicode case mappings, eg.
+	// for email addresses. Unlike TransformFold, it keeps letters such as ß,
+	// so it suits values that are stored or displayed.
+	TransformLower = Transform{"lower", func(value string) string {
+		return cases.Lower(language.Und).String(value)
+	}}
+
+	// TransformFold applies full Unicode case folding, which may change more
+	// than the case of a value, eg. Straße to strasse. Use it only for keys
+	// that values are compared by, and TransformLower for values that are
+	// stored. See CompareFold.
+	TransformFold = Transform{"fold", func(value string) string {
+		return cases.Fold().String(value)
+	}}
+
+	// TransformNFC converts to Unicode normalization form NFC.
+	TransformNFC = Transform{"nfc", norm.NFC.String}
+
+	// TransformStripControl removes control characters, such as NUL, tab and
+	// newline.
+	TransformStripControl = Transform{"strip_control", func(value string) string {
+		return strings.Map(func(c rune) rune {
+			if unicode.IsControl(c) {
+				return -1
+			}
+
+			return c
+		}, value)
+	}}
+
+	// TransformDigits removes all but digits, eg. the punctuation of phone
+	// numbers.
+	TransformDigits = Transform{"digits", func(value string) string {
+		return strings.Map(func(c rune) rune {
+			if !unicode.IsDigit(c) {
+				return -1
+			}
+
+			return c
+		}, value)
+	}}
+)
+
+// StringTransform applies transforms in order to the string value points to,
+// replacing it in place, so that the value validated afterwards is the value
+// stored. StringTransform returns the names of the transforms that changed the
+// value, in order, or nil if none did.
+func StringTransform(value *string, transforms ...Transform) []string {
+	var applied []string
+
+	for _, t := range transforms {
+		v := t.Func(*value)
+
+		if v != *value {
+			*value = v
+			applied = append(applied, t.Name)
+		}
+	}
+
+	return applied
+}
+
+// NumberRound rounds the number value points to to decimals decimal places in
+// place, and reports whether the value changed. Rounding is done on the exact
+// binary value, eg. 2.675 rounds to 2.67 as it is stored as 2.67499... NaN and
+// infinities are left unchanged. NumberRound panics if decimals<0.
+func NumberRound(value *float64, decimals int) bool {
+	if decimals < 0 {
+		panic("decimals must not be negative")
+	}
+
+	if math.IsNaN(*value) || math.IsInf(*value, 0) {
+		return false
+	}
+
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(*value, 'f', decimals, 64), 64)
+
+	if v == *value {
+		return false
+	}
+
+	*value = v
+
+	return true
+}
+
+// transformCollapseSpace replaces each run of white space in value with a
+// single space.
+func transformCollapseSpace(value string) string {
+	var b strings.Builder
+	space := false
+
+	for _, c := range value {
+		if unicode.IsSpace(c) {
+			space = true
+
+			continue
+		}
+
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+
+		b.WriteRune(c)
+	}
+
+	if space {
+		b.WriteByte(' ')
+	}
+
+	return b.String()
+}
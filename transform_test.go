@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestStringTransform(t *testing.T) {
+	tests := []struct {
+		value      string
+		transforms []Transform
+		want       string
+		applied    []string
+	}{
+		{"  Hello   World\t", []Transform{TransformTrim, TransformCollapseSpace}, "Hello World", []string{"trim", "collapse_space"}},
+		{"hello world", []Transform{TransformTrim, TransformCollapseSpace}, "hello world", nil},
+		{"Straße@Example.COM", []Transform{TransformLower}, "straße@example.com", []string{"lower"}},
+		{"ΟΔΥΣΣΕΥΣ", []Transform{TransformLower}, "οδυσσευς", []string{"lower"}},
+		{"Straße", []Transform{TransformFold}, "strasse", []string{"fold"}},
+		{"straße", []Transform{TransformLower, TransformFold}, "strasse", []string{"fold"}},
+		{"cafe\u0301", []Transform{TransformNFC}, "café", []string{"nfc"}},
+		{"a\x00b\nc", []Transform{TransformStripControl}, "abc", []string{"strip_control"}},
+		{"+60 12-345 6789", []Transform{TransformDigits}, "60123456789", []string{"digits"}},
+	}
+
+	for _, tt := range tests {
+		v := tt.value
+
+		if applied := StringTransform(&v, tt.transforms...); v != tt.want || !reflect.DeepEqual(applied, tt.applied) {
+			t.Errorf("StringTransform(%q) = %q, %q, want %q, %q", tt.value, v, applied, tt.want, tt.applied)
+		}
+	}
+}
+
+func TestNumberRound(t *testing.T) {
+	tests := []struct {
+		value    float64
+		decimals int
+		want     float64
+		changed  bool
+	}{
+		{1.005, 2, 1, true},
+		{2.675, 2, 2.67, true},
+		{1.25, 1, 1.2, true},
+		{-1.55, 1, -1.6, true},
+		{1.5, 2, 1.5, false},
+		{123.456, 0, 123, true},
+		{math.Inf(1), 2, math.Inf(1), false},
+	}
+
+	for _, tt := range tests {
+		v := tt.value
+
+		if changed := NumberRound(&v, tt.decimals); v != tt.want || changed != tt.changed {
+			t.Errorf("NumberRound(%v, %v) = %v, %v, want %v, %v", tt.value, tt.decimals, v, changed, tt.want, tt.changed)
+		}
+	}
+}
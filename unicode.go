@@ -0,0 +1,258 @@
+package validation
+
+//go:generate go run gen_unicode.go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	strMixedScriptErrorCode = "MIXED_SCRIPT"
+	strScriptErrorCode      = "SCRIPT"
+	strConfusableErrorCode  = "CONFUSABLE"
+	strBidiControlErrorCode = "BIDI_CONTROL"
+	strZeroWidthErrorCode   = "ZERO_WIDTH"
+)
+
+const (
+	strMixedScriptErrorMessage = "%v contains character(s) of mixed scripts"
+	strScriptErrorMessage      = "%v contains character(s) not in the scripts %v"
+	strConfusableErrorMessage  = "%v is confusable with %v"
+	strBidiControlErrorMessage = "%v contains bidirectional control character(s)"
+	strZeroWidthErrorMessage   = "%v contains zero-width character(s)"
+)
+
+// unicodeScriptNames are the names of the scripts in unicode.Scripts, except
+// Common and Inherited, which are shared by all scripts.
+var unicodeScriptNames = func() []string {
+	var names []string
+
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}()
+
+// unicodeBidiControls are the characters with the Bidi_Control property.
+var unicodeBidiControls = map[rune]struct{}{
+	'\u061C': {}, '\u200E': {}, '\u200F': {},
+	'\u202A': {}, '\u202B': {}, '\u202C': {}, '\u202D': {}, '\u202E': {},
+	'\u2066': {}, '\u2067': {}, '\u2068': {}, '\u2069': {},
+}
+
+// unicodeZeroWidths are the invisible characters without width, other than
+// bidirectional controls.
+var unicodeZeroWidths = map[rune]struct{}{
+	'\u180E': {}, '\u200B': {}, '\u200C': {}, '\u200D': {}, '\u2060': {}, '\uFEFF': {},
+}
+
+// StringNoMixedScript returns error if value contains characters of scripts
+// that are not used together, eg. Latin and Cyrillic letters, otherwise nil.
+// As in UTS #39, characters of the Common and Inherited scripts, such as digits
+// and punctuation, mix with any script, and Han mixes with Hiragana and
+// Katakana, Hangul, or Bopomofo.
+func StringNoMixedScript(field, value string) *ErrValidation {
+	var resolved []string
+
+	for i, c := range value {
+		script := unicodeScript(c)
+
+		if script == "" {
+			continue
+		}
+
+		set := unicodeScriptSet(script)
+
+		if resolved == nil {
+			resolved = set
+
+			continue
+		}
+
+		var intersection []string
+
+		for _, s := range resolved {
+			for _, t := range set {
+				if s == t {
+					intersection = append(intersection, s)
+				}
+			}
+		}
+
+		if len(intersection) == 0 {
+			args := struct {
+				Char   string
+				Index  int
+				Script string
+			}{
+				string(c), i, script,
+			}
+			code := fmt.Sprintf(strErrorCode, strMixedScriptErrorCode)
+			message := fmt.Sprintf(strMixedScriptErrorMessage, field)
+
+			return NewError(code, args, message, field, value)
+		}
+
+		resolved = intersection
+	}
+
+	return nil
+}
+
+// StringAllowedScripts returns error if value contains characters not in
+// scripts, the names of scripts in unicode.Scripts such as Latin or Han,
+// otherwise nil. Characters of the Common and Inherited scripts are always
+// allowed. StringAllowedScripts panics if a script is unknown.
+func StringAllowedScripts(field, value string, scripts []string) *ErrValidation {
+	for _, s := range scripts {
+		if _, ok := unicode.Scripts[s]; !ok {
+			panic("unknown script " + s)
+		}
+	}
+
+	for i, c := range value {
+		script := unicodeScript(c)
+
+		if script != "" && !strContains(scripts, script) {
+			args := struct {
+				Char   string
+				Index  int
+				Script string
+			}{
+				string(c), i, script,
+			}
+			code := fmt.Sprintf(strErrorCode, strScriptErrorCode)
+			message := fmt.Sprintf(strScriptErrorMessage, field, scripts)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringNotConfusable returns error if value is visually confusable with any
+// of reserved, eg. PAYPAI or paypal spelt with Cyrillic а with paypal,
+// otherwise nil. Strings are confusable if their skeletons are equal, ignoring
+// case. See Skeleton.
+func StringNotConfusable(field, value string, reserved []string) *ErrValidation {
+	skeleton := unicodeFoldedSkeleton(value)
+
+	for _, r := range reserved {
+		if unicodeFoldedSkeleton(r) == skeleton {
+			args := struct {
+				Reserved string
+			}{
+				r,
+			}
+			code := fmt.Sprintf(strErrorCode, strConfusableErrorCode)
+			message := fmt.Sprintf(strConfusableErrorMessage, field, r)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// StringNoBidiControl returns error if value contains bidirectional control
+// characters, such as the right-to-left override U+202E, otherwise nil.
+func StringNoBidiControl(field, value string) *ErrValidation {
+	return unicodeReject(field, value, unicodeBidiControls, strBidiControlErrorCode, fmt.Sprintf(strBidiControlErrorMessage, field))
+}
+
+// StringNoZeroWidth returns error if value contains zero-width characters,
+// such as the zero width space U+200B or joiner U+200D, otherwise nil.
+func StringNoZeroWidth(field, value string) *ErrValidation {
+	return unicodeReject(field, value, unicodeZeroWidths, strZeroWidthErrorCode, fmt.Sprintf(strZeroWidthErrorMessage, field))
+}
+
+// Skeleton returns the UTS #39 skeleton of value, ie. value with each
+// character replaced by its prototype among confusable characters, so that
+// confusable strings have equal skeletons. Mappings are generated from
+// unicode_confusables.txt, which covers Latin lookalikes among the Cyrillic,
+// Greek and Armenian letters and the fullwidth forms.
+func Skeleton(value string) string {
+	var b strings.Builder
+
+	for _, c := range norm.NFD.String(value) {
+		if s, ok := unicodeConfusables[c]; ok {
+			b.WriteString(s)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+
+	return norm.NFD.String(b.String())
+}
+
+// unicodeFoldedSkeleton returns the skeleton of value in lowercase. The
+// skeleton is taken before and after lowercasing, as I is confusable with l
+// but i is not.
+func unicodeFoldedSkeleton(value string) string {
+	return Skeleton(strings.ToLower(Skeleton(value)))
+}
+
+// unicodeReject returns error with code and message if value contains any of
+// chars, otherwise nil.
+func unicodeReject(field, value string, chars map[rune]struct{}, code, message string) *ErrValidation {
+	for i, c := range value {
+		if _, ok := chars[c]; ok {
+			args := struct {
+				Char  string
+				Index int
+			}{
+				string(c), i,
+			}
+			code = fmt.Sprintf(strErrorCode, code)
+
+			return NewError(code, args, message, field, value)
+		}
+	}
+
+	return nil
+}
+
+// unicodeScript returns the script of c, or "" if c is of the Common or
+// Inherited script or of no script.
+func unicodeScript(c rune) string {
+	if unicode.Is(unicode.Common, c) || unicode.Is(unicode.Inherited, c) {
+		return ""
+	}
+
+	for _, name := range unicodeScriptNames {
+		if unicode.Is(unicode.Scripts[name], c) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// unicodeScriptSet returns the augmented script set of script as in UTS #39,
+// where Han belongs to the Japanese, Korean and Han with Bopomofo writing
+// systems.
+func unicodeScriptSet(script string) []string {
+	switch script {
+	case "Han":
+		return []string{"Han", "Jpan", "Kore", "Hanb"}
+	case "Hiragana", "Katakana":
+		return []string{script, "Jpan"}
+	case "Hangul":
+		return []string{script, "Kore"}
+	case "Bopomofo":
+		return []string{script, "Hanb"}
+	}
+
+	return []string{script}
+}
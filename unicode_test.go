@@ -0,0 +1,93 @@
+package validation
+
+import "testing"
+
+func TestStringNoMixedScript(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"paypal", ""},
+		{"пример", ""},
+		{"paypal-2024!", ""},
+		{"日本語のテキスト", ""},
+		{"한국어 漢字", ""},
+		{"cafe\u0301", ""},
+		{"p\u0430ypal", "ERROR_STRING_MIXED_SCRIPT"},
+		{"abcαβγ", "ERROR_STRING_MIXED_SCRIPT"},
+		{"ひらがな한글", "ERROR_STRING_MIXED_SCRIPT"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringNoMixedScript("f", tt.value)); code != tt.code {
+			t.Errorf("StringNoMixedScript(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringAllowedScripts(t *testing.T) {
+	tests := []struct {
+		value   string
+		scripts []string
+		code    string
+	}{
+		{"hello, 123", []string{"Latin"}, ""},
+		{"hello мир", []string{"Latin", "Cyrillic"}, ""},
+		{"hello мир", []string{"Latin"}, "ERROR_STRING_SCRIPT"},
+		{"漢字", nil, "ERROR_STRING_SCRIPT"},
+		{"", nil, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringAllowedScripts("f", tt.value, tt.scripts)); code != tt.code {
+			t.Errorf("StringAllowedScripts(%q, %v) = %q, want %q", tt.value, tt.scripts, code, tt.code)
+		}
+	}
+}
+
+func TestStringNotConfusable(t *testing.T) {
+	reserved := []string{"paypal", "admin"}
+
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"paypals", ""},
+		{"administrator", ""},
+		{"paypal", "ERROR_STRING_CONFUSABLE"},
+		{"PAYPAL", "ERROR_STRING_CONFUSABLE"},
+		{"PAYPAI", "ERROR_STRING_CONFUSABLE"},
+		{"p\u0430yp\u0430l", "ERROR_STRING_CONFUSABLE"},
+		{"adrnin", "ERROR_STRING_CONFUSABLE"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringNotConfusable("f", tt.value, reserved)); code != tt.code {
+			t.Errorf("StringNotConfusable(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringInvisibleChars(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(field, value string) *ErrValidation
+		value string
+		code  string
+	}{
+		{"StringNoBidiControl", StringNoBidiControl, "invoice.pdf", ""},
+		{"StringNoBidiControl", StringNoBidiControl, "invoice\u202Efdp.exe", "ERROR_STRING_BIDI_CONTROL"},
+		{"StringNoBidiControl", StringNoBidiControl, "\u2067abc\u2069", "ERROR_STRING_BIDI_CONTROL"},
+		{"StringNoBidiControl", StringNoBidiControl, "a\u200Bb", ""},
+		{"StringNoZeroWidth", StringNoZeroWidth, "admin", ""},
+		{"StringNoZeroWidth", StringNoZeroWidth, "ad\u200Bmin", "ERROR_STRING_ZERO_WIDTH"},
+		{"StringNoZeroWidth", StringNoZeroWidth, "\uFEFFadmin", "ERROR_STRING_ZERO_WIDTH"},
+		{"StringNoZeroWidth", StringNoZeroWidth, "a\u202Eb", ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.fn("f", tt.value)); code != tt.code {
+			t.Errorf("%v(%q) = %q, want %q", tt.name, tt.value, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package validation
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	// Decoders of the image formats supported by FileImageSize.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+const (
+	fileErrorCode = "ERROR_FILE_%v"
+)
+
+const (
+	fileReadErrorCode              = "READ"
+	fileSizeErrorCode              = "SIZE"
+	fileContentTypeErrorCode       = "CONTENT_TYPE"
+	fileExtensionMismatchErrorCode = "EXTENSION_MISMATCH"
+	fileImageErrorCode             = "IMAGE"
+	fileImageSizeErrorCode         = "IMAGE_SIZE"
+)
+
+const (
+	fileReadErrorMessage              = "%v cannot be read"
+	fileSizeErrorMessage              = "size of %v is greater than %v bytes"
+	fileContentTypeErrorMessage       = "content type of %v is not in %v"
+	fileExtensionMismatchErrorMessage = "extension of %v does not match its content type %v"
+	fileImageErrorMessage             = "%v is not a GIF, JPEG or PNG image"
+	fileImageSizeErrorMessage         = "dimensions of %v are not between %vx%v and %vx%v pixels"
+)
+
+// fileExtensionTypes maps file extensions to the content types
+// http.DetectContentType detects for their files.
+var fileExtensionTypes = map[string][]string{
+	".avi":   {"video/avi"},
+	".bmp":   {"image/bmp"},
+	".csv":   {"text/plain"},
+	".docx":  {"application/zip"},
+	".gif":   {"image/gif"},
+	".gz":    {"application/x-gzip"},
+	".htm":   {"text/html"},
+	".html":  {"text/html"},
+	".ico":   {"image/x-icon"},
+	".jpeg":  {"image/jpeg"},
+	".jpg":   {"image/jpeg"},
+	".json":  {"text/plain"},
+	".md":    {"text/plain"},
+	".mp3":   {"audio/mpeg"},
+	".mp4":   {"video/mp4"},
+	".ogg":   {"application/ogg", "audio/ogg"},
+	".otf":   {"font/otf"},
+	".pdf":   {"application/pdf"},
+	".png":   {"image/png"},
+	".pptx":  {"application/zip"},
+	".rar":   {"application/x-rar-compressed"},
+	".svg":   {"text/xml", "text/plain"},
+	".ttf":   {"font/ttf"},
+	".txt":   {"text/plain"},
+	".wasm":  {"application/wasm"},
+	".wav":   {"audio/wave"},
+	".webm":  {"video/webm"},
+	".webp":  {"image/webp"},
+	".woff":  {"font/woff"},
+	".woff2": {"font/woff2"},
+	".xlsx":  {"application/zip"},
+	".xml":   {"text/xml", "text/plain"},
+	".zip":   {"application/zip"},
+}
+
+// FileSize returns error if r has more than max bytes, otherwise nil. FileSize
+// reads at most max+1 bytes from r.
+func FileSize(field string, r io.Reader, max int64) *ErrValidation {
+	limit := max
+
+	// No reader has more than math.MaxInt64 bytes to count.
+	if max < math.MaxInt64 {
+		limit = max + 1
+	}
+
+	n, err := io.Copy(io.Discard, io.LimitReader(r, limit))
+
+	if err != nil {
+		return fileError(field, nil, fileReadErrorCode, fmt.Sprintf(fileReadErrorMessage, field), struct{}{})
+	}
+
+	return fileSize(field, nil, n, max)
+}
+
+// FileHeaderSize returns error if the file of fh has more than max bytes,
+// otherwise nil.
+func FileHeaderSize(field string, fh *multipart.FileHeader, max int64) *ErrValidation {
+	return fileSize(field, fh.Filename, fh.Size, max)
+}
+
+// FileContentType returns error if the content type of r, as detected by
+// http.DetectContentType from its first 512 bytes, is not in allowed,
+// otherwise nil. allowed may contain media ranges, eg. image/*, and content
+// types are compared without parameters, eg. text/plain matches
+// text/plain; charset=utf-8. The detected ContentType is reported in Args.
+func FileContentType(field string, r io.Reader, allowed []string) *ErrValidation {
+	return fileContentType(field, nil, r, allowed)
+}
+
+// FileHeaderContentType is FileContentType for the file of fh.
+func FileHeaderContentType(field string, fh *multipart.FileHeader, allowed []string) *ErrValidation {
+	return fileOpen(field, fh, func(r io.Reader) *ErrValidation {
+		return fileContentType(field, fh.Filename, r, allowed)
+	})
+}
+
+// FileExtensionMatch returns error if the extension of filename does not match
+// the content type of r, as detected by http.DetectContentType from its first
+// 512 bytes, eg. a .png file with JPEG content, otherwise nil. Extensions are
+// matched case-insensitively, and only common extensions of images, audio,
+// video, documents, fonts and archives are checked. The detected ContentType
+// is reported in Args.
+func FileExtensionMatch(field, filename string, r io.Reader) *ErrValidation {
+	return fileExtensionMatch(field, filename, r)
+}
+
+// FileHeaderExtensionMatch is FileExtensionMatch for the filename and file of
+// fh.
+func FileHeaderExtensionMatch(field string, fh *multipart.FileHeader) *ErrValidation {
+	return fileOpen(field, fh, func(r io.Reader) *ErrValidation {
+		return fileExtensionMatch(field, fh.Filename, r)
+	})
+}
+
+// FileImageSize returns error if r is not a GIF, JPEG or PNG image, or if its
+// width is not between minWidth and maxWidth or its height is not between
+// minHeight and maxHeight, otherwise nil. A max of 0 means no limit. Only the
+// header of the image is decoded. The Width and Height are reported in Args.
+func FileImageSize(field string, r io.Reader, minWidth, minHeight, maxWidth, maxHeight int) *ErrValidation {
+	return fileImageSize(field, nil, r, minWidth, minHeight, maxWidth, maxHeight)
+}
+
+// FileHeaderImageSize is FileImageSize for the file of fh.
+func FileHeaderImageSize(field string, fh *multipart.FileHeader, minWidth, minHeight, maxWidth, maxHeight int) *ErrValidation {
+	return fileOpen(field, fh, func(r io.Reader) *ErrValidation {
+		return fileImageSize(field, fh.Filename, r, minWidth, minHeight, maxWidth, maxHeight)
+	})
+}
+
+// fileOpen opens the file of fh and calls fn with it, returning error if the
+// file cannot be opened.
+func fileOpen(field string, fh *multipart.FileHeader, fn func(r io.Reader) *ErrValidation) *ErrValidation {
+	f, err := fh.Open()
+
+	if err != nil {
+		return fileError(field, fh.Filename, fileReadErrorCode, fmt.Sprintf(fileReadErrorMessage, field), struct{}{})
+	}
+
+	defer f.Close()
+
+	return fn(f)
+}
+
+// fileError returns error with code, message and args about the file value,
+// which is its name or nil.
+func fileError(field string, value interface{}, code, message string, args interface{}) *ErrValidation {
+	return NewError(fmt.Sprintf(fileErrorCode, code), args, message, field, value)
+}
+
+func fileSize(field string, value interface{}, size, max int64) *ErrValidation {
+	if size > max {
+		args := struct {
+			Max int64
+		}{
+			max,
+		}
+
+		return fileError(field, value, fileSizeErrorCode, fmt.Sprintf(fileSizeErrorMessage, field, max), args)
+	}
+
+	return nil
+}
+
+func fileContentType(field string, value interface{}, r io.Reader, allowed []string) *ErrValidation {
+	t, err := fileDetect(r)
+
+	if err != nil {
+		return fileError(field, value, fileReadErrorCode, fmt.Sprintf(fileReadErrorMessage, field), struct{}{})
+	}
+
+	for _, a := range allowed {
+		top, sub, _ := strings.Cut(strings.ToLower(a), "/")
+
+		if a == "*/*" || sub == "*" && strings.HasPrefix(t, top+"/") || strings.ToLower(a) == t {
+			return nil
+		}
+	}
+
+	args := struct {
+		ContentType string
+	}{
+		t,
+	}
+
+	return fileError(field, value, fileContentTypeErrorCode, fmt.Sprintf(fileContentTypeErrorMessage, field, allowed), args)
+}
+
+func fileExtensionMatch(field, filename string, r io.Reader) *ErrValidation {
+	types, ok := fileExtensionTypes[strings.ToLower(filepath.Ext(filename))]
+
+	if !ok {
+		return nil
+	}
+
+	t, err := fileDetect(r)
+
+	if err != nil {
+		return fileError(field, filename, fileReadErrorCode, fmt.Sprintf(fileReadErrorMessage, field), struct{}{})
+	}
+
+	if !strContains(types, t) {
+		args := struct {
+			ContentType string
+		}{
+			t,
+		}
+
+		return fileError(field, filename, fileExtensionMismatchErrorCode, fmt.Sprintf(fileExtensionMismatchErrorMessage, field, t), args)
+	}
+
+	return nil
+}
+
+func fileImageSize(field string, value interface{}, r io.Reader, minWidth, minHeight, maxWidth, maxHeight int) *ErrValidation {
+	c, _, err := image.DecodeConfig(r)
+
+	if err != nil {
+		return fileError(field, value, fileImageErrorCode, fmt.Sprintf(fileImageErrorMessage, field), struct{}{})
+	}
+
+	if c.Width < minWidth || c.Height < minHeight || maxWidth > 0 && c.Width > maxWidth || maxHeight > 0 && c.Height > maxHeight {
+		args := struct {
+			Width, Height int
+		}{
+			c.Width, c.Height,
+		}
+		message := fmt.Sprintf(fileImageSizeErrorMessage, field, minWidth, minHeight, fileDimension(maxWidth), fileDimension(maxHeight))
+
+		return fileError(field, value, fileImageSizeErrorCode, message, args)
+	}
+
+	return nil
+}
+
+// fileDetect returns the content type of r, as detected by
+// http.DetectContentType from its first 512 bytes, without parameters.
+func fileDetect(r io.Reader) (string, error) {
+	b := make([]byte, 512)
+	n, err := io.ReadFull(r, b)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	t, _, err := mime.ParseMediaType(http.DetectContentType(b[:n]))
+
+	return t, err
+}
+
+// fileDimension returns max, or ∞ if max is 0, ie. no limit.
+func fileDimension(max int) interface{} {
+	if max == 0 {
+		return "∞"
+	}
+
+	return max
+}
@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFileSize(t *testing.T) {
+	tests := []struct {
+		value string
+		max   int64
+		code  string
+	}{
+		{"", 0, ""},
+		{"hello", 5, ""},
+		{"hello", 4, "ERROR_FILE_SIZE"},
+		{"hello", 0, "ERROR_FILE_SIZE"},
+		{"hello", math.MaxInt64 - 1, ""},
+		{"hello", math.MaxInt64, ""},
+	}
+
+	for _, tt := range tests {
+		r := strings.NewReader(tt.value)
+
+		if code := errCode(FileSize("f", r, tt.max)); code != tt.code {
+			t.Errorf("FileSize(%q, %v) = %q, want %q", tt.value, tt.max, code, tt.code)
+		}
+
+		if tt.code == "" && r.Len() != 0 {
+			t.Errorf("FileSize(%q, %v) left %v bytes unread", tt.value, tt.max, r.Len())
+		}
+	}
+
+	if code := errCode(FileSize("f", io.MultiReader(strings.NewReader("a"), uploadErrReader{}), 10)); code != "ERROR_FILE_READ" {
+		t.Errorf("FileSize(failing reader) = %q, want %q", code, "ERROR_FILE_READ")
+	}
+}
+
+func TestFileContentType(t *testing.T) {
+	tests := []struct {
+		value   string
+		allowed []string
+		code    string
+	}{
+		{"%PDF-1.7", []string{"application/pdf"}, ""},
+		{"hello", []string{"text/plain"}, ""},
+		{"hello", []string{"text/*"}, ""},
+		{"hello", []string{"*/*"}, ""},
+		{"hello", []string{"image/*"}, "ERROR_FILE_CONTENT_TYPE"},
+		{"\x89PNG\r\n\x1a\n", []string{"image/*"}, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(FileContentType("f", strings.NewReader(tt.value), tt.allowed)); code != tt.code {
+			t.Errorf("FileContentType(%q, %v) = %q, want %q", tt.value, tt.allowed, code, tt.code)
+		}
+	}
+}
+
+func TestFileExtensionMatch(t *testing.T) {
+	tests := []struct {
+		filename, value string
+		code            string
+	}{
+		{"a.png", "\x89PNG\r\n\x1a\n", ""},
+		{"a.PNG", "\x89PNG\r\n\x1a\n", ""},
+		{"a.jpg", "\x89PNG\r\n\x1a\n", "ERROR_FILE_EXTENSION_MISMATCH"},
+		{"a.txt", "hello", ""},
+		{"a.unknown", "hello", ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(FileExtensionMatch("f", tt.filename, strings.NewReader(tt.value))); code != tt.code {
+			t.Errorf("FileExtensionMatch(%q) = %q, want %q", tt.filename, code, tt.code)
+		}
+	}
+}
+
+func TestFileImageSize(t *testing.T) {
+	var b bytes.Buffer
+
+	if err := png.Encode(&b, image.NewGray(image.Rect(0, 0, 40, 30))); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		minWidth, minHeight, maxWidth, maxHeight int
+		code                                     string
+	}{
+		{0, 0, 0, 0, ""},
+		{40, 30, 40, 30, ""},
+		{41, 0, 0, 0, "ERROR_FILE_IMAGE_SIZE"},
+		{0, 0, 0, 29, "ERROR_FILE_IMAGE_SIZE"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(FileImageSize("f", bytes.NewReader(b.Bytes()), tt.minWidth, tt.minHeight, tt.maxWidth, tt.maxHeight)); code != tt.code {
+			t.Errorf("FileImageSize(%v, %v, %v, %v) = %q, want %q", tt.minWidth, tt.minHeight, tt.maxWidth, tt.maxHeight, code, tt.code)
+		}
+	}
+
+	if code := errCode(FileImageSize("f", strings.NewReader("hello"), 0, 0, 0, 0)); code != "ERROR_FILE_IMAGE" {
+		t.Errorf("FileImageSize(text) = %q, want %q", code, "ERROR_FILE_IMAGE")
+	}
+}
+
+// uploadErrReader is a reader that always fails.
+type uploadErrReader struct{}
+
+func (uploadErrReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
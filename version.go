@@ -0,0 +1,489 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	strSemverErrorCode            = "SEMVER"
+	strVersionMinErrorCode        = "VERSION_MIN"
+	strVersionMaxErrorCode        = "VERSION_MAX"
+	strVersionBetweenErrorCode    = "VERSION_BETWEEN"
+	strVersionConstraintErrorCode = "VERSION_CONSTRAINT"
+)
+
+const (
+	strSemverErrorMessage            = "%v is not a semantic version"
+	strVersionMinErrorMessage        = "%v is smaller than %v"
+	strVersionMaxErrorMessage        = "%v is greater than %v"
+	strVersionBetweenErrorMessage    = "%v is not between %v and %v"
+	strVersionConstraintErrorMessage = "%v does not satisfy %v"
+)
+
+// versionPattern is the regular expression of SemVer 2.0.0 versions suggested
+// by semver.org.
+var versionPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// versionPartialPattern is the regular expression of versions in constraints,
+// where trailing components may be omitted or be x, X or *.
+var versionPartialPattern = regexp.MustCompile(`^(0|[1-9]\d*|[xX*])(?:\.(0|[1-9]\d*|[xX*]))?(?:\.(0|[1-9]\d*|[xX*]))?` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// Version is a semantic version reported in Args by the StringVersion* family
+// of functions.
+type Version struct {
+	Major, Minor, Patch uint64
+	Prerelease          []string
+	Build               []string
+}
+
+// versionComparator is a comparison of versions with a fully specified
+// version, op being one of =, !=, >, >=, < or <=.
+type versionComparator struct {
+	op string
+	v  Version
+}
+
+// StringSemver returns error if value is not a SemVer 2.0.0 version, eg.
+// 1.2.3-beta.1+build.5, otherwise nil. The v prefix is not allowed.
+func StringSemver(field, value string) *ErrValidation {
+	if _, ok := versionParse(value); !ok {
+		return versionError(field, value)
+	}
+
+	return nil
+}
+
+// StringVersionMin returns error if value is not a SemVer 2.0.0 version, or if
+// value<min in SemVer precedence, otherwise nil. Build metadata is ignored.
+// StringVersionMin panics if min is not a version.
+func StringVersionMin(field, value, min string) *ErrValidation {
+	m := versionMustParse(min, "min")
+	v, ok := versionParse(value)
+
+	if !ok {
+		return versionError(field, value)
+	}
+
+	if versionCompare(v, m) < 0 {
+		args := struct {
+			Min     string
+			Version Version
+		}{
+			min, v,
+		}
+		code := fmt.Sprintf(strErrorCode, strVersionMinErrorCode)
+		message := fmt.Sprintf(strVersionMinErrorMessage, field, min)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringVersionMax returns error if value is not a SemVer 2.0.0 version, or if
+// value>max in SemVer precedence, otherwise nil. Build metadata is ignored.
+// StringVersionMax panics if max is not a version.
+func StringVersionMax(field, value, max string) *ErrValidation {
+	m := versionMustParse(max, "max")
+	v, ok := versionParse(value)
+
+	if !ok {
+		return versionError(field, value)
+	}
+
+	if versionCompare(v, m) > 0 {
+		args := struct {
+			Max     string
+			Version Version
+		}{
+			max, v,
+		}
+		code := fmt.Sprintf(strErrorCode, strVersionMaxErrorCode)
+		message := fmt.Sprintf(strVersionMaxErrorMessage, field, max)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringVersionBetween returns error if value is not a SemVer 2.0.0 version,
+// or if value<min or value>max in SemVer precedence, otherwise nil. Build
+// metadata is ignored. StringVersionBetween panics if min or max is not a
+// version, or if min>max.
+func StringVersionBetween(field, value, min, max string) *ErrValidation {
+	lo := versionMustParse(min, "min")
+	hi := versionMustParse(max, "max")
+
+	if versionCompare(lo, hi) > 0 {
+		panic("min must not be greater than max")
+	}
+
+	v, ok := versionParse(value)
+
+	if !ok {
+		return versionError(field, value)
+	}
+
+	if versionCompare(v, lo) < 0 || versionCompare(v, hi) > 0 {
+		args := struct {
+			Min, Max string
+			Version  Version
+		}{
+			min, max, v,
+		}
+		code := fmt.Sprintf(strErrorCode, strVersionBetweenErrorCode)
+		message := fmt.Sprintf(strVersionBetweenErrorMessage, field, min, max)
+
+		return NewError(code, args, message, field, value)
+	}
+
+	return nil
+}
+
+// StringVersionConstraint returns error if value is not a SemVer 2.0.0
+// version, or if value does not satisfy constraint, otherwise nil. constraint
+// is made up of comparators separated by spaces, all of which must be
+// satisfied, eg. ">=1.2 <2", and alternatives separated by ||. Comparators are
+// a version prefixed with =, !=, >, >=, <, <=, ~ (patch updates, eg. ~1.2.3 is
+// >=1.2.3 <1.3.0) or ^ (updates not changing the first non-zero component, eg.
+// ^0.2.3 is >=0.2.3 <0.3.0), which may be followed by spaces, eg. ">= 1.2".
+// The version of a comparator may omit trailing components or use x or * for
+// them, eg. 1.2 or 1.2.x is >=1.2.0 <1.3.0, but no component may follow x or
+// *, eg. 1.x.3. Prereleases are compared by SemVer precedence, so 2.0.0-rc.1
+// satisfies <2. StringVersionConstraint panics if constraint is invalid.
+func StringVersionConstraint(field, value, constraint string) *ErrValidation {
+	alternatives, ok := versionParseConstraint(constraint)
+
+	if !ok {
+		panic("invalid version constraint " + constraint)
+	}
+
+	v, ok := versionParse(value)
+
+	if !ok {
+		return versionError(field, value)
+	}
+
+	for _, comparators := range alternatives {
+		if versionSatisfies(v, comparators) {
+			return nil
+		}
+	}
+
+	args := struct {
+		Constraint string
+		Version    Version
+	}{
+		constraint, v,
+	}
+	code := fmt.Sprintf(strErrorCode, strVersionConstraintErrorCode)
+	message := fmt.Sprintf(strVersionConstraintErrorMessage, field, constraint)
+
+	return NewError(code, args, message, field, value)
+}
+
+// versionError returns error of value not being a semantic version.
+func versionError(field, value string) *ErrValidation {
+	code := fmt.Sprintf(strErrorCode, strSemverErrorCode)
+	message := fmt.Sprintf(strSemverErrorMessage, field)
+
+	return NewError(code, struct{}{}, message, field, value)
+}
+
+// versionParse parses value as a SemVer 2.0.0 version.
+func versionParse(value string) (Version, bool) {
+	v, n, ok := versionParsePartial(value, versionPattern)
+
+	return v, ok && n == 3
+}
+
+// versionMustParse parses value as a SemVer 2.0.0 version, panicking if it
+// is not one.
+func versionMustParse(value, name string) Version {
+	v, ok := versionParse(value)
+
+	if !ok {
+		panic(name + " must be a semantic version")
+	}
+
+	return v
+}
+
+// versionParsePartial parses value with pattern, returning the version with
+// omitted components set to 0, and the number of components specified.
+func versionParsePartial(value string, pattern *regexp.Regexp) (Version, int, bool) {
+	m := pattern.FindStringSubmatch(value)
+
+	if m == nil {
+		return Version{}, 0, false
+	}
+
+	var v Version
+	n := 0
+
+	for i, p := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if m[i+1] == "" || strings.ContainsAny(m[i+1], "xX*") {
+			for _, c := range m[i+2 : 4] {
+				if c != "" && !strings.ContainsAny(c, "xX*") {
+					return Version{}, 0, false
+				}
+			}
+
+			break
+		}
+
+		u, err := strconv.ParseUint(m[i+1], 10, 64)
+
+		if err != nil {
+			return Version{}, 0, false
+		}
+
+		*p = u
+		n++
+	}
+
+	if (m[4] != "" || m[5] != "") && n < 3 {
+		return Version{}, 0, false
+	}
+
+	if m[4] != "" {
+		v.Prerelease = strings.Split(m[4], ".")
+	}
+
+	if m[5] != "" {
+		v.Build = strings.Split(m[5], ".")
+	}
+
+	return v, n, true
+}
+
+// versionParseConstraint parses constraint into alternatives of comparators.
+func versionParseConstraint(constraint string) ([][]versionComparator, bool) {
+	var alternatives [][]versionComparator
+
+	for _, alt := range strings.Split(constraint, "||") {
+		var comparators []versionComparator
+		fields := strings.Fields(alt)
+
+		if len(fields) == 0 {
+			return nil, false
+		}
+
+		for i := 0; i < len(fields); i++ {
+			c := fields[i]
+
+			// An operator may be separated from its version, eg. >= 1.2.
+			if l := versionOpLen(c); l > 0 && l == len(c) && i+1 < len(fields) {
+				i++
+				c += fields[i]
+			}
+
+			op := c[:versionOpLen(c)]
+			v, n, ok := versionParsePartial(c[len(op):], versionPartialPattern)
+
+			if !ok || op == "!=" && n < 3 || n == 0 && op != "" && op != "=" {
+				return nil, false
+			}
+
+			comparators = append(comparators, versionExpand(op, v, n)...)
+		}
+
+		alternatives = append(alternatives, comparators)
+	}
+
+	return alternatives, true
+}
+
+// versionOpLen returns the length of the operator prefixing comparator c.
+func versionOpLen(c string) int {
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(c, op) {
+			return len(op)
+		}
+	}
+
+	return 0
+}
+
+// versionExpand expands the comparator op with v, of which n components are
+// specified, into comparators with fully specified versions. A comparator
+// without any component, eg. *, matches all versions.
+func versionExpand(op string, v Version, n int) []versionComparator {
+	lo := v
+	hi, bounded := versionBump(v, n)
+
+	switch op {
+	case "~":
+		if n >= 2 {
+			hi, bounded = versionBump(v, 2)
+		}
+	case "^":
+		switch {
+		case v.Major > 0 || n == 1:
+			hi, bounded = versionBump(v, 1)
+		case v.Minor > 0 || n == 2:
+			hi, bounded = versionBump(v, 2)
+		default:
+			hi, bounded = versionBump(v, 3)
+		}
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	if n == 3 && op != "~" && op != "^" {
+		if op == "" {
+			op = "="
+		}
+
+		return []versionComparator{{op, v}}
+	}
+
+	// If v cannot be bumped, no version is above it, so there is no upper
+	// bound and > matches no version.
+	switch op {
+	case ">":
+		if !bounded {
+			return []versionComparator{{"<", lo}, {">=", lo}}
+		}
+
+		return []versionComparator{{">=", hi}}
+	case ">=":
+		return []versionComparator{{">=", lo}}
+	case "<":
+		return []versionComparator{{"<", lo}}
+	case "<=":
+		if !bounded {
+			return nil
+		}
+
+		return []versionComparator{{"<", hi}}
+	}
+
+	if !bounded {
+		return []versionComparator{{">=", lo}}
+	}
+
+	return []versionComparator{{">=", lo}, {"<", hi}}
+}
+
+// versionBump returns v incremented at its nth component, ie. 1 for major, 2
+// for minor and 3 for patch, with the following components set to 0. A
+// component at math.MaxUint64 carries over to the one before it, eg. 1.x with
+// a maximal minor is bumped to 2.0.0. versionBump returns false if all
+// components up to the nth are at math.MaxUint64. v is returned without
+// prerelease and build metadata.
+func versionBump(v Version, n int) (Version, bool) {
+	switch {
+	case n >= 3 && v.Patch < math.MaxUint64:
+		return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, true
+	case n >= 2 && v.Minor < math.MaxUint64:
+		return Version{Major: v.Major, Minor: v.Minor + 1}, true
+	case v.Major < math.MaxUint64:
+		return Version{Major: v.Major + 1}, true
+	}
+
+	return Version{}, false
+}
+
+// versionSatisfies reports whether v satisfies all of comparators.
+func versionSatisfies(v Version, comparators []versionComparator) bool {
+	for _, c := range comparators {
+		r := versionCompare(v, c.v)
+		ok := true
+
+		switch c.op {
+		case "=":
+			ok = r == 0
+		case "!=":
+			ok = r != 0
+		case ">":
+			ok = r > 0
+		case ">=":
+			ok = r >= 0
+		case "<":
+			ok = r < 0
+		case "<=":
+			ok = r <= 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// versionCompare returns -1, 0 or 1 if a is lower than, equal to or higher than
+// b in SemVer precedence.
+func versionCompare(a, b Version) int {
+	for _, p := range [][2]uint64{{a.Major, b.Major}, {a.Minor, b.Minor}, {a.Patch, b.Patch}} {
+		if p[0] != p[1] {
+			if p[0] < p[1] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	// A version without prerelease is higher than one with.
+	switch {
+	case len(a.Prerelease) == 0 && len(b.Prerelease) == 0:
+		return 0
+	case len(a.Prerelease) == 0:
+		return 1
+	case len(b.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.Prerelease) && i < len(b.Prerelease); i++ {
+		if r := versionCompareIdentifier(a.Prerelease[i], b.Prerelease[i]); r != 0 {
+			return r
+		}
+	}
+
+	switch {
+	case len(a.Prerelease) < len(b.Prerelease):
+		return -1
+	case len(a.Prerelease) > len(b.Prerelease):
+		return 1
+	}
+
+	return 0
+}
+
+// versionCompareIdentifier compares prerelease identifiers, numeric ones
+// numerically and lower than alphanumeric ones, which are compared in ASCII
+// order.
+func versionCompareIdentifier(a, b string) int {
+	an, bn := strDigits(a), strDigits(b)
+
+	switch {
+	case an && bn:
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+
+			return 1
+		}
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
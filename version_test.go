@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestStringSemver(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"1.2.3", ""},
+		{"0.0.0", ""},
+		{"1.2.3-beta.1+build.5", ""},
+		{"1.0.0-0.3.7", ""},
+		{"18446744073709551615.0.0", ""},
+		{"18446744073709551616.0.0", "ERROR_STRING_SEMVER"},
+		{"v1.2.3", "ERROR_STRING_SEMVER"},
+		{"1.2", "ERROR_STRING_SEMVER"},
+		{"01.2.3", "ERROR_STRING_SEMVER"},
+		{"1.2.3-01", "ERROR_STRING_SEMVER"},
+		{"1.2.3-", "ERROR_STRING_SEMVER"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringSemver("f", tt.value)); code != tt.code {
+			t.Errorf("StringSemver(%q) = %q, want %q", tt.value, code, tt.code)
+		}
+	}
+}
+
+func TestStringVersionRange(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ErrValidation
+		code string
+	}{
+		{"StringVersionMin", StringVersionMin("f", "1.2.3", "1.2.3"), ""},
+		{"StringVersionMin", StringVersionMin("f", "1.2.3-rc.1", "1.2.3"), "ERROR_STRING_VERSION_MIN"},
+		{"StringVersionMin", StringVersionMin("f", "1.10.0", "1.9.0"), ""},
+		{"StringVersionMax", StringVersionMax("f", "1.2.3+build", "1.2.3"), ""},
+		{"StringVersionMax", StringVersionMax("f", "1.2.4", "1.2.3"), "ERROR_STRING_VERSION_MAX"},
+		{"StringVersionBetween", StringVersionBetween("f", "1.0.0-alpha.beta", "1.0.0-alpha.1", "1.0.0"), ""},
+		{"StringVersionBetween", StringVersionBetween("f", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0"), "ERROR_STRING_VERSION_BETWEEN"},
+		{"StringVersionBetween", StringVersionBetween("f", "1.0", "1.0.0", "2.0.0"), "ERROR_STRING_SEMVER"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(tt.err); code != tt.code {
+			t.Errorf("%v() = %q, want %q", tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestStringVersionConstraint(t *testing.T) {
+	tests := []struct {
+		value, constraint string
+		code              string
+	}{
+		{"1.2.0", ">=1.2 <2", ""},
+		{"1.2.0", ">= 1.2 < 2", ""},
+		{"1.2.0", ">=  1.2", ""},
+		{"2.0.0", ">=1.2 <2", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"2.0.0-rc.1", "<2", ""},
+		{"1.3.0", "1.2.x", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1.2.9", "1.2.*", ""},
+		{"1.9.9", "1", ""},
+		{"5.0.0", "*", ""},
+		{"1.2.3", "=1.2.3", ""},
+		{"1.2.4", "!=1.2.3", ""},
+		{"1.2.3", "!= 1.2.3", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1.3.0", ">1.2", ""},
+		{"1.2.9", ">1.2", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1.2.9", "<=1.2", ""},
+		{"1.2.9", "~1.2.3", ""},
+		{"1.3.0", "~1.2.3", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1.9.0", "~1", ""},
+		{"1.9.0", "^1.2.3", ""},
+		{"0.3.0", "^0.2.3", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"0.0.4", "^0.0.3", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"3.0.0", "^1.2 || ^3", ""},
+		{"2.5.0", "^1.2 || ^3", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1.2", ">=1", "ERROR_STRING_SEMVER"},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringVersionConstraint("f", tt.value, tt.constraint)); code != tt.code {
+			t.Errorf("StringVersionConstraint(%q, %q) = %q, want %q", tt.value, tt.constraint, code, tt.code)
+		}
+	}
+}
+
+func TestStringVersionConstraintInvalid(t *testing.T) {
+	for _, constraint := range []string{"", "||", ">=", ">= >=1", "1.x.3", "*.2", "x.x.1", ">1.2.3 ||", "!=1.2", ">*", "v1", "1.2.3.4"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("StringVersionConstraint(%q) did not panic", constraint)
+				}
+			}()
+
+			StringVersionConstraint("f", "1.0.0", constraint)
+		}()
+	}
+}
+
+func TestStringVersionConstraintMaxUint64(t *testing.T) {
+	max := "18446744073709551615"
+
+	tests := []struct {
+		value, constraint string
+		code              string
+	}{
+		{max + "." + max + "." + max, "^" + max, ""},
+		{max + ".0.0", ">=" + max, ""},
+		{max + "." + max + "." + max, "<=" + max, ""},
+		{max + "." + max + "." + max, ">" + max, "ERROR_STRING_VERSION_CONSTRAINT"},
+		{max + ".1.0", "~" + max + ".0.0", "ERROR_STRING_VERSION_CONSTRAINT"},
+		{max + ".0." + max, "~" + max + ".0.0", ""},
+		{"2.0.0", "1." + max, "ERROR_STRING_VERSION_CONSTRAINT"},
+		{"1." + max + ".5", "1." + max, ""},
+	}
+
+	for _, tt := range tests {
+		if code := errCode(StringVersionConstraint("f", tt.value, tt.constraint)); code != tt.code {
+			t.Errorf("StringVersionConstraint(%q, %q) = %q, want %q", tt.value, tt.constraint, code, tt.code)
+		}
+	}
+}
+
+func TestVersionBump(t *testing.T) {
+	const max = math.MaxUint64
+
+	tests := []struct {
+		v    Version
+		n    int
+		want Version
+		ok   bool
+	}{
+		{Version{1, 2, 3, []string{"rc"}, nil}, 3, Version{Major: 1, Minor: 2, Patch: 4}, true},
+		{Version{1, 2, 3, nil, nil}, 2, Version{Major: 1, Minor: 3}, true},
+		{Version{1, 2, 3, nil, nil}, 1, Version{Major: 2}, true},
+		{Version{1, 2, max, nil, nil}, 3, Version{Major: 1, Minor: 3}, true},
+		{Version{1, max, max, nil, nil}, 3, Version{Major: 2}, true},
+		{Version{1, max, 0, nil, nil}, 2, Version{Major: 2}, true},
+		{Version{max, max, max, nil, nil}, 3, Version{}, false},
+		{Version{max, max, 0, nil, nil}, 2, Version{}, false},
+		{Version{max, 0, 0, nil, nil}, 1, Version{}, false},
+	}
+
+	for _, tt := range tests {
+		if got, ok := versionBump(tt.v, tt.n); !reflect.DeepEqual(got, tt.want) || ok != tt.ok {
+			t.Errorf("versionBump(%v, %v) = %v, %v, want %v, %v", tt.v, tt.n, got, ok, tt.want, tt.ok)
+		}
+	}
+}